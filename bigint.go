@@ -0,0 +1,233 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// BigInt is a nullable arbitrary-precision integer, for numeric(78,0) and
+// similar columns that overflow int64.
+type BigInt Option[*big.Int]
+
+// BigIntJSONEncoding is how BigInt MarshalJSON writes values. It defaults to
+// strings, as JavaScript numbers can't hold integers above 2^53.
+// UnmarshalJSON always accepts both numbers and strings.
+var BigIntJSONEncoding = NumberAsString
+
+func NewBigInt(value *big.Int, hasValue bool) BigInt {
+	opt := &BigInt{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeBigInt returns a BigInt containing value.
+func SomeBigInt(value *big.Int) BigInt {
+	return NewBigInt(value, true)
+}
+
+// NoneBigInt returns a BigInt containing no value.
+func NoneBigInt() BigInt {
+	return BigInt{}
+}
+
+// SetValue performs the conversion.
+func (opt *BigInt) SetValue(value *big.Int) {
+	(*Option[*big.Int])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *BigInt) Clear() {
+	(*Option[*big.Int])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *BigInt) Take() BigInt {
+	return BigInt((*Option[*big.Int])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *BigInt) Replace(value *big.Int) BigInt {
+	return BigInt((*Option[*big.Int])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *BigInt) GetOrInsert(value *big.Int) *big.Int {
+	return (*Option[*big.Int])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *BigInt) GetOrInsertWith(fn func() *big.Int) *big.Int {
+	return (*Option[*big.Int])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt BigInt) Unwrap() (*big.Int, bool) {
+	return Option[*big.Int](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt BigInt) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt BigInt) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt BigInt) UnwrapOr(def *big.Int) *big.Int {
+	return Option[*big.Int](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt BigInt) UnwrapOrElse(fn func() *big.Int) *big.Int {
+	return Option[*big.Int](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt BigInt) UnwrapOrDefault() *big.Int {
+	return Option[*big.Int](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt BigInt) UnwrapOrPanic() *big.Int {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap BigInt")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt BigInt) Or(optb BigInt) BigInt {
+	return BigInt(Option[*big.Int](opt).Or(Option[*big.Int](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt BigInt) OrElse(fn func() BigInt) BigInt {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt BigInt) And(optb BigInt) BigInt {
+	return BigInt(Option[*big.Int](opt).And(Option[*big.Int](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt BigInt) Xor(optb BigInt) BigInt {
+	return BigInt(Option[*big.Int](opt).Xor(Option[*big.Int](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt BigInt) Filter(pred func(*big.Int) bool) BigInt {
+	return BigInt(Option[*big.Int](opt).Filter(pred))
+}
+
+func (opt BigInt) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt BigInt) getValue() *big.Int {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt BigInt) String() string {
+	return Option[*big.Int](opt).String()
+}
+
+// text returns the contained value in base 10. A nil *big.Int is 0.
+func (opt BigInt) text() string {
+	if opt.getValue() == nil {
+		return "0"
+	}
+	return opt.getValue().Text(10)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt BigInt) MarshalJSON() ([]byte, error) {
+	if !opt.getHasValue() {
+		return []byte("null"), nil
+	}
+
+	if BigIntJSONEncoding == NumberAsString {
+		return []byte(`"` + opt.text() + `"`), nil
+	}
+	return []byte(opt.text()), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *BigInt) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || data == nil {
+		opt.value, opt.hasValue = nil, false
+		return nil
+	}
+
+	s := string(data)
+	if data[0] == '"' {
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			opt.hasValue = false
+			return errors.WithStack(err)
+		}
+	}
+
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		opt.hasValue = false
+		return errors.Errorf("null: invalid BigInt %q", s)
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface. Values are read from decimal
+// text, so they never go through float64.
+func (opt *BigInt) Scan(src interface{}) error {
+	if src == nil {
+		opt.value, opt.hasValue = nil, false
+		return nil
+	}
+
+	var s string
+	err := internal.ConvertAssign(&s, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return errors.Errorf("null: converting %q to a BigInt", s)
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. The value is sent as decimal
+// text.
+func (opt BigInt) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+	return opt.text(), nil
+}
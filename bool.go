@@ -3,18 +3,17 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
 
 	"github.com/pkg/errors"
 
 	"github.com/Gurpartap/null/internal"
 )
 
-type Bool struct {
-	hasValue bool
-	value    bool
-}
+type Bool Option[bool]
+
+// BoolJSONLenient makes Bool UnmarshalJSON also accept the strings "true" and
+// "false" and the numbers 1 and 0, which loosely typed clients send.
+var BoolJSONLenient = false
 
 func NewBool(value bool, hasValue bool) Bool {
 	opt := &Bool{}
@@ -24,41 +23,78 @@ func NewBool(value bool, hasValue bool) Bool {
 	return *opt
 }
 
+// SomeBool returns a Bool containing value.
+func SomeBool(value bool) Bool {
+	return NewBool(value, true)
+}
+
+// NoneBool returns a Bool containing no value.
+func NoneBool() Bool {
+	return Bool{}
+}
+
 // SetValue performs the conversion.
 func (opt *Bool) SetValue(value bool) {
-	opt.value = value
-	opt.hasValue = true
+	(*Option[bool])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Bool) Clear() {
+	(*Option[bool])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Bool) Take() Bool {
+	return Bool((*Option[bool])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Bool) Replace(value bool) Bool {
+	return Bool((*Option[bool])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Bool) GetOrInsert(value bool) bool {
+	return (*Option[bool])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Bool) GetOrInsertWith(fn func() bool) bool {
+	return (*Option[bool])(opt).GetOrInsertWith(fn)
 }
 
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt Bool) Unwrap() (bool, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[bool](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Bool) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Bool) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt Bool) UnwrapOr(def bool) bool {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[bool](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt Bool) UnwrapOrElse(fn func() bool) bool {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[bool](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt Bool) UnwrapOrDefault() bool {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return false
+	return Option[bool](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -66,7 +102,90 @@ func (opt Bool) UnwrapOrPanic() bool {
 	if opt.getHasValue() {
 		return opt.getValue()
 	}
-	panic("unable to unwrap Int64")
+	panic("unable to unwrap Bool")
+}
+
+// Or returns opt OR optb in SQL's three-valued logic, where None is NULL:
+// true if either is true, false if both are false, and None otherwise. For
+// the Option combinator, convert to Option[bool] first.
+func (opt Bool) Or(optb Bool) Bool {
+	switch {
+	case opt.IsTrue() || optb.IsTrue():
+		return SomeBool(true)
+	case opt.IsFalse() && optb.IsFalse():
+		return SomeBool(false)
+	}
+	return NoneBool()
+}
+
+// OrElse is Or with a lazily computed optb: it returns true without calling
+// fn if the optional is true, and otherwise opt OR fn() in SQL's three-valued
+// logic, so that b.OrElse(func() Bool { return x }) equals b.Or(x).
+func (opt Bool) OrElse(fn func() Bool) Bool {
+	if opt.IsTrue() {
+		return opt
+	}
+	return opt.Or(fn())
+}
+
+// And returns opt AND optb in SQL's three-valued logic, where None is NULL:
+// false if either is false, true if both are true, and None otherwise. For
+// the Option combinator, convert to Option[bool] first.
+func (opt Bool) And(optb Bool) Bool {
+	switch {
+	case opt.IsFalse() || optb.IsFalse():
+		return SomeBool(false)
+	case opt.IsTrue() && optb.IsTrue():
+		return SomeBool(true)
+	}
+	return NoneBool()
+}
+
+// Xor returns opt XOR optb in SQL's three-valued logic: None if either is
+// None, otherwise whether they differ. For the Option combinator, convert to
+// Option[bool] first.
+func (opt Bool) Xor(optb Bool) Bool {
+	if opt.IsNone() || optb.IsNone() {
+		return NoneBool()
+	}
+	return SomeBool(opt.getValue() != optb.getValue())
+}
+
+// Not returns NOT opt: None if opt is None, otherwise its negation.
+func (opt Bool) Not() Bool {
+	if opt.IsNone() {
+		return NoneBool()
+	}
+	return SomeBool(!opt.getValue())
+}
+
+// Implies returns opt implies optb, i.e. NOT opt OR optb, in SQL's
+// three-valued logic. It is true when opt is false or optb is true, even if
+// the other is None.
+func (opt Bool) Implies(optb Bool) Bool {
+	return opt.Not().Or(optb)
+}
+
+// IsTrue returns true if the optional contains true, like SQL's IS TRUE.
+func (opt Bool) IsTrue() bool {
+	return opt.getHasValue() && opt.getValue()
+}
+
+// IsFalse returns true if the optional contains false, like SQL's IS FALSE.
+func (opt Bool) IsFalse() bool {
+	return opt.getHasValue() && !opt.getValue()
+}
+
+// IsUnknown returns true if the optional contains no value, like SQL's IS
+// UNKNOWN. It is the same as IsNone.
+func (opt Bool) IsUnknown() bool {
+	return !opt.getHasValue()
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Bool) Filter(pred func(bool) bool) Bool {
+	return Bool(Option[bool](opt).Filter(pred))
 }
 
 func (opt Bool) getHasValue() bool {
@@ -79,48 +198,48 @@ func (opt Bool) getValue() bool {
 
 // String conforms to fmt Stringer interface.
 func (opt Bool) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[bool](opt).String()
 }
 
 // MarshalJSON implements the json Marshaler interface.
 func (opt Bool) MarshalJSON() ([]byte, error) {
-	if !opt.getHasValue() {
-		return []byte("null"), nil
-	}
-	return json.Marshal(opt.getValue())
+	return Option[bool](opt).MarshalJSON()
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
+// UnmarshalJSON implements the json Unmarshaler interface. See
+// BoolJSONLenient for the other forms it can accept.
 func (opt *Bool) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) || data == nil {
-		opt.value, opt.hasValue = false, false
-		return nil
-	}
-
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
-		opt.hasValue = false
-		return errors.WithStack(err)
+	if BoolJSONLenient {
+		switch {
+		case bytes.Equal(data, []byte(`"true"`)), bytes.Equal(data, []byte("1")):
+			opt.SetValue(true)
+			return nil
+		case bytes.Equal(data, []byte(`"false"`)), bytes.Equal(data, []byte("0")):
+			opt.SetValue(false)
+			return nil
+		}
 	}
-	opt.hasValue = true
-
-	return nil
+	return (*Option[bool])(opt).UnmarshalJSON(data)
 }
 
-// Scan implements the sql Scanner interface.
+// Scan implements the sql Scanner interface. Besides bool and the integers 1
+// and 0, it reads the text forms of booleans, such as Postgres 't' and 'f',
+// 'yes' and 'no', and 'on' and 'off', and MySQL BIT(1) bytes.
 func (opt *Bool) Scan(src interface{}) error {
-	if src == nil {
-		opt.value, opt.hasValue = false, false
-		return nil
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return (*Option[bool])(opt).Scan(src)
 	}
 
-	var value bool
-	err := internal.ConvertAssign(&value, src)
-	if err != nil {
-		return errors.WithStack(err)
+	value, ok := internal.ParseBool(s)
+	if !ok {
+		opt.value, opt.hasValue = false, false
+		return errors.Errorf("null: cannot parse %q as Bool", s)
 	}
 	opt.SetValue(value)
 
@@ -129,8 +248,5 @@ func (opt *Bool) Scan(src interface{}) error {
 
 // Value implements the driver Valuer interface.
 func (opt Bool) Value() (driver.Value, error) {
-	if !opt.getHasValue() {
-		return nil, nil
-	}
-	return opt.getValue(), nil
+	return Option[bool](opt).Value()
 }
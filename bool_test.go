@@ -0,0 +1,127 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBoolScan(t *testing.T) {
+	tests := []struct {
+		src  interface{}
+		want Bool
+	}{
+		{nil, NoneBool()},
+		{true, SomeBool(true)},
+		{false, SomeBool(false)},
+		{int64(1), SomeBool(true)},
+		{int64(0), SomeBool(false)},
+		{"t", SomeBool(true)},
+		{"f", SomeBool(false)},
+		{"TRUE", SomeBool(true)},
+		{"false", SomeBool(false)},
+		{"yes", SomeBool(true)},
+		{"No", SomeBool(false)},
+		{"on", SomeBool(true)},
+		{"off", SomeBool(false)},
+		{"1", SomeBool(true)},
+		{" 0 ", SomeBool(false)},
+		{[]byte("t"), SomeBool(true)},
+		{[]byte{0x01}, SomeBool(true)},
+		{[]byte{0x00}, SomeBool(false)},
+	}
+	for _, tt := range tests {
+		var got Bool
+		if err := got.Scan(tt.src); err != nil || got != tt.want {
+			t.Errorf("Scan(%#v) = %s, %v, want %s", tt.src, got, err, tt.want)
+		}
+	}
+
+	for _, src := range []interface{}{"maybe", "", []byte{0x02}, int64(2), 1.5} {
+		got := SomeBool(true)
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) = %s, want error", src, got)
+		}
+	}
+}
+
+func TestBoolUnmarshalJSONLenient(t *testing.T) {
+	defer func(lenient bool) { BoolJSONLenient = lenient }(BoolJSONLenient)
+
+	tests := []struct {
+		in      string
+		lenient bool
+		want    Bool
+		wantErr bool
+	}{
+		{`true`, false, SomeBool(true), false},
+		{`null`, false, NoneBool(), false},
+		{`"true"`, false, NoneBool(), true},
+		{`1`, false, NoneBool(), true},
+		{`"true"`, true, SomeBool(true), false},
+		{`"false"`, true, SomeBool(false), false},
+		{`1`, true, SomeBool(true), false},
+		{`0`, true, SomeBool(false), false},
+		{`null`, true, NoneBool(), false},
+		{`2`, true, NoneBool(), true},
+		{`"t"`, true, NoneBool(), true},
+	}
+	for _, tt := range tests {
+		BoolJSONLenient = tt.lenient
+		var got Bool
+		err := json.Unmarshal([]byte(tt.in), &got)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && got != tt.want) {
+			t.Errorf("Unmarshal(%s) lenient=%t = %s, %v, want %s", tt.in, tt.lenient, got, err, tt.want)
+		}
+	}
+}
+
+func TestBoolThreeValuedLogic(t *testing.T) {
+	T, F, N := SomeBool(true), SomeBool(false), NoneBool()
+	tests := []struct {
+		a, b                  Bool
+		and, or, xor, implies Bool
+	}{
+		{T, T, T, T, F, T},
+		{T, F, F, T, T, F},
+		{T, N, N, T, N, N},
+		{F, T, F, T, T, T},
+		{F, F, F, F, F, T},
+		{F, N, F, N, N, T},
+		{N, T, N, T, N, T},
+		{N, F, F, N, N, N},
+		{N, N, N, N, N, N},
+	}
+	for _, tt := range tests {
+		if got := tt.a.And(tt.b); got != tt.and {
+			t.Errorf("%s AND %s = %s, want %s", tt.a, tt.b, got, tt.and)
+		}
+		if got := tt.a.Or(tt.b); got != tt.or {
+			t.Errorf("%s OR %s = %s, want %s", tt.a, tt.b, got, tt.or)
+		}
+		if got := tt.a.OrElse(func() Bool { return tt.b }); got != tt.or {
+			t.Errorf("%s OrElse %s = %s, want %s", tt.a, tt.b, got, tt.or)
+		}
+		if got := tt.a.Xor(tt.b); got != tt.xor {
+			t.Errorf("%s XOR %s = %s, want %s", tt.a, tt.b, got, tt.xor)
+		}
+		if got := tt.a.Implies(tt.b); got != tt.implies {
+			t.Errorf("%s IMPLIES %s = %s, want %s", tt.a, tt.b, got, tt.implies)
+		}
+	}
+
+	if T.Not() != F || F.Not() != T || N.Not() != N {
+		t.Error("Not is wrong")
+	}
+	if !T.IsTrue() || T.IsFalse() || T.IsUnknown() {
+		t.Error("IS TRUE is wrong")
+	}
+	if F.IsTrue() || !F.IsFalse() || F.IsUnknown() {
+		t.Error("IS FALSE is wrong")
+	}
+	if N.IsTrue() || N.IsFalse() || !N.IsUnknown() {
+		t.Error("IS UNKNOWN is wrong")
+	}
+	if T.OrElse(func() Bool { panic("OrElse called fn for true") }) != T {
+		t.Error("true OrElse is not true")
+	}
+}
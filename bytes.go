@@ -1,20 +1,8 @@
 package null
 
-import (
-	"bytes"
-	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+import "database/sql/driver"
 
-	"github.com/pkg/errors"
-
-	"github.com/Gurpartap/null/internal"
-)
-
-type Bytes struct {
-	hasValue bool
-	value    []byte
-}
+type Bytes Option[[]byte]
 
 func NewBytes(value []byte, hasValue bool) Bytes {
 	opt := &Bytes{}
@@ -24,41 +12,78 @@ func NewBytes(value []byte, hasValue bool) Bytes {
 	return *opt
 }
 
+// SomeBytes returns a Bytes containing value.
+func SomeBytes(value []byte) Bytes {
+	return NewBytes(value, true)
+}
+
+// NoneBytes returns a Bytes containing no value.
+func NoneBytes() Bytes {
+	return Bytes{}
+}
+
 // SetValue performs the conversion.
 func (opt *Bytes) SetValue(value []byte) {
-	opt.value = value
-	opt.hasValue = true
+	(*Option[[]byte])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None and releases the backing array.
+func (opt *Bytes) Clear() {
+	(*Option[[]byte])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Bytes) Take() Bytes {
+	return Bytes((*Option[[]byte])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Bytes) Replace(value []byte) Bytes {
+	return Bytes((*Option[[]byte])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Bytes) GetOrInsert(value []byte) []byte {
+	return (*Option[[]byte])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Bytes) GetOrInsertWith(fn func() []byte) []byte {
+	return (*Option[[]byte])(opt).GetOrInsertWith(fn)
 }
 
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt Bytes) Unwrap() ([]byte, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[[]byte](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Bytes) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Bytes) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt Bytes) UnwrapOr(def []byte) []byte {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[[]byte](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt Bytes) UnwrapOrElse(fn func() []byte) []byte {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[[]byte](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt Bytes) UnwrapOrDefault() []byte {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return nil
+	return Option[[]byte](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -69,6 +94,37 @@ func (opt Bytes) UnwrapOrPanic() []byte {
 	panic("unable to unwrap Bytes")
 }
 
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Bytes) Or(optb Bytes) Bytes {
+	return Bytes(Option[[]byte](opt).Or(Option[[]byte](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Bytes) OrElse(fn func() Bytes) Bytes {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Bytes) And(optb Bytes) Bytes {
+	return Bytes(Option[[]byte](opt).And(Option[[]byte](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Bytes) Xor(optb Bytes) Bytes {
+	return Bytes(Option[[]byte](opt).Xor(Option[[]byte](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Bytes) Filter(pred func([]byte) bool) Bytes {
+	return Bytes(Option[[]byte](opt).Filter(pred))
+}
+
 func (opt Bytes) getHasValue() bool {
 	return opt.hasValue
 }
@@ -79,58 +135,25 @@ func (opt Bytes) getValue() []byte {
 
 // String conforms to fmt Stringer interface.
 func (opt Bytes) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[[]byte](opt).String()
 }
 
 // MarshalJSON implements the json Marshaler interface.
 func (opt Bytes) MarshalJSON() ([]byte, error) {
-	if !opt.getHasValue() {
-		return []byte("null"), nil
-	}
-	return json.Marshal(opt.getValue())
+	return Option[[]byte](opt).MarshalJSON()
 }
 
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (opt *Bytes) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) || data == nil {
-		opt.value, opt.hasValue = nil, false
-		return nil
-	}
-
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
-		opt.hasValue = false
-		return errors.WithStack(err)
-	}
-	opt.hasValue = true
-
-	return nil
+	return (*Option[[]byte])(opt).UnmarshalJSON(data)
 }
 
 // Scan implements the sql Scanner interface.
 func (opt *Bytes) Scan(src interface{}) error {
-	if src == nil {
-		opt.value, opt.hasValue = nil, false
-		return nil
-	}
-
-	var value []byte
-	err := internal.ConvertAssign(&value, src)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	opt.SetValue(value)
-
-	return nil
+	return (*Option[[]byte])(opt).Scan(src)
 }
 
 // Value implements the driver Valuer interface.
 func (opt Bytes) Value() (driver.Value, error) {
-	if !opt.getHasValue() {
-		return nil, nil
-	}
-	return []byte(opt.getValue()), nil
+	return Option[[]byte](opt).Value()
 }
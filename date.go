@@ -0,0 +1,371 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// Date is a nullable calendar date, for date columns. Unlike Time it has no
+// time of day or location, so it can't shift to the day before when shown in
+// another timezone.
+type Date Option[CivilDate]
+
+func NewDate(value CivilDate, hasValue bool) Date {
+	opt := &Date{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeDate returns a Date containing value.
+func SomeDate(value CivilDate) Date {
+	return NewDate(value, true)
+}
+
+// NoneDate returns a Date containing no value.
+func NoneDate() Date {
+	return Date{}
+}
+
+// SetValue performs the conversion.
+func (opt *Date) SetValue(value CivilDate) {
+	(*Option[CivilDate])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Date) Clear() {
+	(*Option[CivilDate])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Date) Take() Date {
+	return Date((*Option[CivilDate])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Date) Replace(value CivilDate) Date {
+	return Date((*Option[CivilDate])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Date) GetOrInsert(value CivilDate) CivilDate {
+	return (*Option[CivilDate])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Date) GetOrInsertWith(fn func() CivilDate) CivilDate {
+	return (*Option[CivilDate])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Date) Unwrap() (CivilDate, bool) {
+	return Option[CivilDate](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Date) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Date) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Date) UnwrapOr(def CivilDate) CivilDate {
+	return Option[CivilDate](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Date) UnwrapOrElse(fn func() CivilDate) CivilDate {
+	return Option[CivilDate](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Date) UnwrapOrDefault() CivilDate {
+	return Option[CivilDate](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Date) UnwrapOrPanic() CivilDate {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Date")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Date) Or(optb Date) Date {
+	return Date(Option[CivilDate](opt).Or(Option[CivilDate](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Date) OrElse(fn func() Date) Date {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Date) And(optb Date) Date {
+	return Date(Option[CivilDate](opt).And(Option[CivilDate](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Date) Xor(optb Date) Date {
+	return Date(Option[CivilDate](opt).Xor(Option[CivilDate](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Date) Filter(pred func(CivilDate) bool) Date {
+	return Date(Option[CivilDate](opt).Filter(pred))
+}
+
+func (opt Date) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Date) getValue() CivilDate {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Date) String() string {
+	return Option[CivilDate](opt).String()
+}
+
+// AddDays returns the contained date moved by n days, or None.
+func (opt Date) AddDays(n int) Date {
+	if !opt.getHasValue() {
+		return Date{}
+	}
+	return SomeDate(opt.getValue().AddDays(n))
+}
+
+// Before returns true if both optionals contain a date and opt is before
+// optb.
+func (opt Date) Before(optb Date) bool {
+	return opt.getHasValue() && optb.getHasValue() && opt.getValue().Before(optb.getValue())
+}
+
+// After returns true if both optionals contain a date and opt is after optb.
+func (opt Date) After(optb Date) bool {
+	return opt.getHasValue() && optb.getHasValue() && opt.getValue().After(optb.getValue())
+}
+
+// In returns midnight at the start of the contained date in loc, or None.
+func (opt Date) In(loc *time.Location) Time {
+	if !opt.getHasValue() {
+		return Time{}
+	}
+	return SomeTime(opt.getValue().In(loc))
+}
+
+// MarshalJSON implements the json Marshaler interface. The date is written as
+// "2006-01-02".
+func (opt Date) MarshalJSON() ([]byte, error) {
+	if !opt.getHasValue() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + opt.getValue().String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || data == nil {
+		opt.value, opt.hasValue = CivilDate{}, false
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		opt.hasValue = false
+		return errors.WithStack(err)
+	}
+
+	value, err := ParseDate(s)
+	if err != nil {
+		opt.hasValue = false
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface. It accepts a time.Time, whose
+// date is taken in its own location, or "2006-01-02" text.
+func (opt *Date) Scan(src interface{}) error {
+	if src == nil {
+		opt.value, opt.hasValue = CivilDate{}, false
+		return nil
+	}
+
+	var value CivilDate
+	err := value.Scan(src)
+	if err != nil {
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. The date is sent as
+// "2006-01-02" text.
+func (opt Date) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+	return opt.getValue().Value()
+}
+
+// CivilDate is a date in the proleptic Gregorian calendar, without a time of
+// day or location.
+type CivilDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// dateLayout is the ISO 8601 date format used by Postgres and MySQL.
+const dateLayout = "2006-01-02"
+
+// DateOf returns the date of t in t's location.
+func DateOf(t time.Time) CivilDate {
+	year, month, day := t.Date()
+	return CivilDate{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a "2006-01-02" date.
+func ParseDate(s string) (CivilDate, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return CivilDate{}, errors.Errorf("null: invalid date %q", s)
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date as "2006-01-02".
+func (d CivilDate) String() string {
+	return d.In(time.UTC).Format(dateLayout)
+}
+
+// IsValid returns true if the date exists, e.g. it is not February 30.
+func (d CivilDate) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// In returns midnight at the start of the date in loc.
+func (d CivilDate) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d. n may be negative.
+func (d CivilDate) AddDays(n int) CivilDate {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// DaysSince returns the number of days from e to d. It is computed from Unix
+// seconds, as a time.Duration only spans about 292 years.
+func (d CivilDate) DaysSince(e CivilDate) int {
+	const secondsPerDay = 24 * 60 * 60
+	return int((d.In(time.UTC).Unix() - e.In(time.UTC).Unix()) / secondsPerDay)
+}
+
+// Before returns true if d is before e.
+func (d CivilDate) Before(e CivilDate) bool {
+	return d.Compare(e) < 0
+}
+
+// After returns true if d is after e.
+func (d CivilDate) After(e CivilDate) bool {
+	return d.Compare(e) > 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether d is before, equal to, or
+// after e.
+func (d CivilDate) Compare(e CivilDate) int {
+	switch {
+	case d.Year != e.Year:
+		return cmpInt(d.Year, e.Year)
+	case d.Month != e.Month:
+		return cmpInt(int(d.Month), int(e.Month))
+	}
+	return cmpInt(d.Day, e.Day)
+}
+
+// MarshalText implements the encoding TextMarshaler interface.
+func (d CivilDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding TextUnmarshaler interface.
+func (d *CivilDate) UnmarshalText(text []byte) error {
+	value, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+// Scan implements the sql Scanner interface, so Option[CivilDate] and
+// Patch[CivilDate] can be scanned. It accepts a time.Time, whose date is taken
+// in its own location, or "2006-01-02" text. NULL is an error; use Date for
+// nullable columns.
+func (d *CivilDate) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return errors.New("null: cannot scan NULL into CivilDate")
+	case time.Time:
+		*d = DateOf(v)
+		return nil
+	}
+
+	var s string
+	err := internal.ConvertAssign(&s, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	value, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+// Value implements the driver Valuer interface, so Option[CivilDate] and
+// Patch[CivilDate] can be sent. The date is sent as "2006-01-02" text.
+func (d CivilDate) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,63 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    CivilDate
+		wantErr bool
+	}{
+		{"2024-02-29", CivilDate{2024, time.February, 29}, false},
+		{"0001-01-01", CivilDate{1, time.January, 1}, false},
+		{"9999-12-31", CivilDate{9999, time.December, 31}, false},
+		{"2023-02-29", CivilDate{}, true},
+		{"2024-13-01", CivilDate{}, true},
+		{"2024-1-1", CivilDate{}, true},
+		{"2024-01-01T00:00:00Z", CivilDate{}, true},
+		{"", CivilDate{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDate(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v (error %t)", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestCivilDateDaysSince(t *testing.T) {
+	tests := []struct {
+		d, e CivilDate
+		want int
+	}{
+		{CivilDate{2024, 1, 1}, CivilDate{2024, 1, 1}, 0},
+		{CivilDate{2024, 3, 1}, CivilDate{2024, 2, 28}, 2},
+		{CivilDate{2023, 3, 1}, CivilDate{2023, 2, 28}, 1},
+		{CivilDate{2024, 1, 1}, CivilDate{2024, 1, 2}, -1},
+		{CivilDate{1970, 1, 1}, CivilDate{1969, 12, 31}, 1},
+		{CivilDate{2024, 1, 1}, CivilDate{1, 1, 1}, 738885},
+		{CivilDate{1, 1, 1}, CivilDate{2024, 1, 1}, -738885},
+		{CivilDate{9999, 12, 31}, CivilDate{1, 1, 1}, 3652058},
+	}
+	for _, tt := range tests {
+		if got := tt.d.DaysSince(tt.e); got != tt.want {
+			t.Errorf("%s.DaysSince(%s) = %d, want %d", tt.d, tt.e, got, tt.want)
+		}
+		if back := tt.e.AddDays(tt.want); back != tt.d {
+			t.Errorf("%s.AddDays(%d) = %s, want %s", tt.e, tt.want, back, tt.d)
+		}
+	}
+}
+
+func TestCivilDateCompare(t *testing.T) {
+	a, b := CivilDate{2024, 1, 31}, CivilDate{2024, 2, 1}
+	if !a.Before(b) || !b.After(a) || a.Compare(a) != 0 {
+		t.Errorf("%s and %s compare wrong", a, b)
+	}
+	if (CivilDate{2024, 2, 30}).IsValid() || !(CivilDate{2024, 2, 29}).IsValid() {
+		t.Error("IsValid is wrong about February")
+	}
+}
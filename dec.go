@@ -0,0 +1,370 @@
+package null
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// Dec is an arbitrary-precision decimal number, stored as an unscaled integer
+// and a scale: the value is unscaled × 10^-scale. Unlike float64 it represents
+// numeric columns exactly, and it keeps their scale, so "1.50" stays "1.50".
+//
+// Dec values are immutable; every operation returns a new Dec. The zero value
+// is 0.
+type Dec struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// RoundingMode selects how Round and Quo drop digits.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to nearest, and ties away from zero. This is how
+	// Postgres rounds numeric values.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to nearest, and ties to the even neighbour
+	// (banker's rounding).
+	RoundHalfEven
+	// RoundHalfDown rounds to nearest, and ties toward zero.
+	RoundHalfDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds toward zero, i.e. truncates.
+	RoundDown
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+)
+
+// Limits on the scale of parsed decimals, which keep the exponent of untrusted
+// input such as "1e50000000" from costing a huge power of ten. They are the
+// limits of Postgres numeric: 16383 digits after the decimal point, and
+// 131072 before it.
+const (
+	decMaxScale = 16383
+	decMinScale = -131072
+)
+
+// NewDec returns unscaled × 10^-scale. unscaled is copied.
+func NewDec(unscaled *big.Int, scale int32) Dec {
+	d := Dec{unscaled: new(big.Int).Set(unscaled), scale: scale}
+	if scale < 0 {
+		d.unscaled.Mul(d.unscaled, pow10(-int64(scale)))
+		d.scale = 0
+	}
+	return d
+}
+
+// DecFromInt64 returns value as a Dec with scale 0.
+func DecFromInt64(value int64) Dec {
+	return Dec{unscaled: big.NewInt(value)}
+}
+
+// ParseDec parses a decimal number such as "-12.3400" or "1.5e-3". The scale
+// of the result is the number of digits after the decimal point, adjusted by
+// the exponent. Like Postgres numeric, it rejects numbers with more than 16383
+// digits after the decimal point or 131072 before it.
+func ParseDec(s string) (Dec, error) {
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Dec{}, errors.Errorf("null: invalid decimal %q", s)
+		}
+		mantissa, exp = s[:i], e
+	}
+
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Dec{}, errors.Errorf("null: invalid decimal %q", s)
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if scale > decMaxScale || scale < decMinScale {
+		return Dec{}, errors.Errorf("null: decimal %q out of range", s)
+	}
+	unscaled, _ := new(big.Int).SetString(sign+digits, 10)
+	return NewDec(unscaled, int32(scale)), nil
+}
+
+// MustParseDec is like ParseDec but panics if s can't be parsed.
+func MustParseDec(s string) Dec {
+	d, err := ParseDec(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (d Dec) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Unscaled returns a copy of the unscaled integer value of d.
+func (d Dec) Unscaled() *big.Int {
+	return new(big.Int).Set(d.int())
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Dec) Scale() int32 {
+	return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Dec) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero returns true if d is 0, at any scale.
+func (d Dec) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares d and e and returns -1, 0 or +1. Scale is ignored, so 1.5 and
+// 1.50 are equal.
+func (d Dec) Cmp(e Dec) int {
+	x, y := align(d, e)
+	return x.Cmp(y)
+}
+
+// Equal returns true if d and e are the same number, at any scale.
+func (d Dec) Equal(e Dec) bool {
+	return d.Cmp(e) == 0
+}
+
+// Neg returns -d.
+func (d Dec) Neg() Dec {
+	return Dec{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns |d|.
+func (d Dec) Abs() Dec {
+	return Dec{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Add returns d + e, at the larger scale of the two.
+func (d Dec) Add(e Dec) Dec {
+	x, y := align(d, e)
+	return Dec{unscaled: x.Add(x, y), scale: maxScale(d, e)}
+}
+
+// Sub returns d - e, at the larger scale of the two.
+func (d Dec) Sub(e Dec) Dec {
+	x, y := align(d, e)
+	return Dec{unscaled: x.Sub(x, y), scale: maxScale(d, e)}
+}
+
+// Mul returns d × e, at the sum of the scales of the two.
+func (d Dec) Mul(e Dec) Dec {
+	return NewDec(new(big.Int).Mul(d.int(), e.int()), d.scale+e.scale)
+}
+
+// Quo returns d / e rounded to scale digits after the decimal point. It
+// panics if e is 0.
+func (d Dec) Quo(e Dec, scale int32, mode RoundingMode) Dec {
+	if e.IsZero() {
+		panic("null: division of Dec by zero")
+	}
+
+	// d / e = (d.unscaled × 10^e.scale) / (e.unscaled × 10^d.scale), which is
+	// scaled up by 10^scale before the integer division.
+	num := new(big.Int).Mul(d.int(), pow10(int64(e.scale)))
+	den := new(big.Int).Mul(e.int(), pow10(int64(d.scale)))
+	if scale >= 0 {
+		num.Mul(num, pow10(int64(scale)))
+	} else {
+		den.Mul(den, pow10(-int64(scale)))
+	}
+	return NewDec(roundQuo(num, den, mode), scale)
+}
+
+// Round returns d with scale digits after the decimal point, dropping digits
+// with mode if that lowers the scale, and appending zeros if it raises it. A
+// negative scale rounds to tens, hundreds, and so on.
+func (d Dec) Round(scale int32, mode RoundingMode) Dec {
+	if scale >= d.scale {
+		return NewDec(d.int(), d.scale).rescale(scale)
+	}
+	q := roundQuo(d.int(), pow10(int64(d.scale)-int64(scale)), mode)
+	return NewDec(q, scale)
+}
+
+// Truncate returns d with at most scale digits after the decimal point,
+// dropping the rest. It is Round with RoundDown.
+func (d Dec) Truncate(scale int32) Dec {
+	return d.Round(scale, RoundDown)
+}
+
+// rescale raises the scale of d, which it modifies in place.
+func (d Dec) rescale(scale int32) Dec {
+	d.unscaled.Mul(d.unscaled, pow10(int64(scale)-int64(d.scale)))
+	d.scale = scale
+	return d
+}
+
+// Rat returns d as an exact rational number.
+func (d Dec) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.int(), pow10(int64(d.scale)))
+}
+
+// Float64 returns the float64 nearest to d, and whether it is exact.
+func (d Dec) Float64() (float64, bool) {
+	return d.Rat().Float64()
+}
+
+// Int64 returns the integer part of d, and whether it fits an int64.
+func (d Dec) Int64() (int64, bool) {
+	i := d.Truncate(0).int()
+	return i.Int64(), i.IsInt64()
+}
+
+// String returns d in plain decimal notation, with exactly Scale digits after
+// the decimal point.
+func (d Dec) String() string {
+	s := d.int().String()
+	if d.scale == 0 {
+		return s
+	}
+
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	if len(s) <= int(d.scale) {
+		s = strings.Repeat("0", int(d.scale)-len(s)+1) + s
+	}
+	i := len(s) - int(d.scale)
+	return sign + s[:i] + "." + s[i:]
+}
+
+// MarshalText implements the encoding TextMarshaler interface.
+func (d Dec) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding TextUnmarshaler interface.
+func (d *Dec) UnmarshalText(text []byte) error {
+	value, err := ParseDec(string(text))
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+// Scan implements the sql Scanner interface, so Option[Dec] and Patch[Dec]
+// can be scanned. Numeric columns are read from the text the driver hands
+// over, without going through float64. NULL is an error; use Decimal for
+// nullable columns.
+func (d *Dec) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		return errors.New("null: cannot scan NULL into Dec")
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		s = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		err := internal.ConvertAssign(&s, src)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	value, err := ParseDec(s)
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+// Value implements the driver Valuer interface, so Option[Dec] and Patch[Dec]
+// can be sent. The value is sent as exact decimal text.
+func (d Dec) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+func maxScale(d, e Dec) int32 {
+	if d.scale > e.scale {
+		return d.scale
+	}
+	return e.scale
+}
+
+// align returns the unscaled values of d and e at their common scale. The
+// results are fresh copies.
+func align(d, e Dec) (*big.Int, *big.Int) {
+	x, y := new(big.Int).Set(d.int()), new(big.Int).Set(e.int())
+	if d.scale < e.scale {
+		x.Mul(x, pow10(int64(e.scale)-int64(d.scale)))
+	} else if e.scale < d.scale {
+		y.Mul(y, pow10(int64(d.scale)-int64(e.scale)))
+	}
+	return x, y
+}
+
+// roundQuo returns num / den rounded to an integer with mode.
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// sign is the sign of the exact quotient, which q is truncated toward.
+	sign := num.Sign() * den.Sign()
+	away := false
+	switch mode {
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = sign > 0
+	case RoundFloor:
+		away = sign < 0
+	default:
+		half := new(big.Int).Abs(r)
+		half.Lsh(half, 1)
+		switch half.Cmp(new(big.Int).Abs(den)) {
+		case 1:
+			away = true
+		case 0:
+			switch mode {
+			case RoundHalfUp:
+				away = true
+			case RoundHalfEven:
+				away = q.Bit(0) == 1
+			}
+		}
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
@@ -0,0 +1,176 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDec(t *testing.T) {
+	tests := []struct {
+		in    string
+		want  string
+		scale int32
+	}{
+		{"0", "0", 0},
+		{"12.3400", "12.3400", 4},
+		{"-12.34", "-12.34", 2},
+		{"+5", "5", 0},
+		{".5", "0.5", 1},
+		{"5.", "5", 0},
+		{"-0.001", "-0.001", 3},
+		{"1.5e-3", "0.0015", 4},
+		{"1.5E3", "1500", 0},
+		{"1e2", "100", 0},
+		{"12.345e1", "123.45", 2},
+		{"1e-16383", "0." + strings.Repeat("0", 16382) + "1", 16383},
+	}
+	for _, tt := range tests {
+		d, err := ParseDec(tt.in)
+		if err != nil {
+			t.Errorf("ParseDec(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got := d.String(); got != tt.want || d.Scale() != tt.scale {
+			t.Errorf("ParseDec(%q) = %s (scale %d), want %s (scale %d)", tt.in, got, d.Scale(), tt.want, tt.scale)
+		}
+	}
+}
+
+func TestParseDecInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"-",
+		".",
+		"abc",
+		"1.2.3",
+		"1e",
+		"1e+",
+		"1ee2",
+		"--1",
+		"1,5",
+		"NaN",
+		"1e50000000",
+		"1e-50000000",
+		"1e-16384",
+		"1e131073",
+		"1e99999999999",
+	}
+	for _, in := range tests {
+		if d, err := ParseDec(in); err == nil {
+			t.Errorf("ParseDec(%q) = %s, want error", in, d)
+		}
+	}
+}
+
+func TestParseDecHugeExponentIsFast(t *testing.T) {
+	start := time.Now()
+	var opt Decimal
+	for _, in := range []string{`1e50000000`, `"1e10000000"`, `1e-50000000`} {
+		if err := json.Unmarshal([]byte(in), &opt); err == nil {
+			t.Errorf("UnmarshalJSON(%s) = %s, want error", in, opt)
+		}
+	}
+	if err := opt.Scan("1e50000000"); err == nil {
+		t.Errorf("Scan(1e50000000) = %s, want error", opt)
+	}
+	var at TimeAs[UnixSeconds]
+	if err := json.Unmarshal([]byte(`1e50000000`), &at); err == nil {
+		t.Errorf("UnixSeconds UnmarshalJSON(1e50000000) = %s, want error", at)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("rejecting huge exponents took %s", elapsed)
+	}
+}
+
+func TestDecRound(t *testing.T) {
+	tests := []struct {
+		in    string
+		scale int32
+		mode  RoundingMode
+		want  string
+	}{
+		{"2.5", 0, RoundHalfUp, "3"},
+		{"-2.5", 0, RoundHalfUp, "-3"},
+		{"2.5", 0, RoundHalfEven, "2"},
+		{"3.5", 0, RoundHalfEven, "4"},
+		{"-2.5", 0, RoundHalfEven, "-2"},
+		{"2.5", 0, RoundHalfDown, "2"},
+		{"2.51", 0, RoundHalfDown, "3"},
+		{"2.1", 0, RoundUp, "3"},
+		{"-2.1", 0, RoundUp, "-3"},
+		{"2.9", 0, RoundDown, "2"},
+		{"-2.9", 0, RoundDown, "-2"},
+		{"2.1", 0, RoundCeiling, "3"},
+		{"-2.9", 0, RoundCeiling, "-2"},
+		{"2.9", 0, RoundFloor, "2"},
+		{"-2.1", 0, RoundFloor, "-3"},
+		{"1.005", 2, RoundHalfUp, "1.01"},
+		{"1.5", 3, RoundHalfUp, "1.500"},
+		{"1250", -2, RoundHalfEven, "1200"},
+		{"1350", -2, RoundHalfEven, "1400"},
+		{"0.0001", 2, RoundHalfUp, "0.00"},
+	}
+	for _, tt := range tests {
+		got := MustParseDec(tt.in).Round(tt.scale, tt.mode).String()
+		if got != tt.want {
+			t.Errorf("%s.Round(%d, %d) = %s, want %s", tt.in, tt.scale, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestDecQuo(t *testing.T) {
+	tests := []struct {
+		d, e  string
+		scale int32
+		mode  RoundingMode
+		want  string
+	}{
+		{"1", "3", 4, RoundHalfUp, "0.3333"},
+		{"2", "3", 4, RoundHalfUp, "0.6667"},
+		{"-2", "3", 4, RoundDown, "-0.6666"},
+		{"1", "8", 2, RoundHalfEven, "0.12"},
+		{"3", "8", 2, RoundHalfEven, "0.38"},
+		{"10.50", "0.5", 0, RoundHalfUp, "21"},
+		{"1", "-4", 1, RoundHalfUp, "-0.3"},
+		{"1", "-4", 1, RoundHalfDown, "-0.2"},
+	}
+	for _, tt := range tests {
+		got := MustParseDec(tt.d).Quo(MustParseDec(tt.e), tt.scale, tt.mode).String()
+		if got != tt.want {
+			t.Errorf("%s.Quo(%s, %d, %d) = %s, want %s", tt.d, tt.e, tt.scale, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestDecArithmetic(t *testing.T) {
+	a, b := MustParseDec("1.50"), MustParseDec("-0.125")
+	tests := []struct {
+		name string
+		got  Dec
+		want string
+	}{
+		{"Add", a.Add(b), "1.375"},
+		{"Sub", a.Sub(b), "1.625"},
+		{"Mul", a.Mul(b), "-0.18750"},
+		{"Neg", b.Neg(), "0.125"},
+		{"Abs", b.Abs(), "0.125"},
+		{"Truncate", b.Truncate(2), "-0.12"},
+	}
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if !MustParseDec("1.5").Equal(MustParseDec("1.500")) {
+		t.Error("1.5 != 1.500")
+	}
+	if MustParseDec("-1").Cmp(MustParseDec("0.1")) != -1 {
+		t.Error("-1 >= 0.1")
+	}
+	if !(Dec{}).IsZero() || (Dec{}).String() != "0" {
+		t.Errorf("zero Dec = %s", Dec{})
+	}
+}
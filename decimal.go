@@ -0,0 +1,258 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Decimal is a nullable arbitrary-precision decimal, for numeric columns.
+type Decimal Option[Dec]
+
+// NumberEncoding selects how MarshalJSON writes arbitrary-precision numbers.
+type NumberEncoding int
+
+const (
+	// NumberAsNumber writes a JSON number, e.g. 12.50. Many JSON decoders
+	// read numbers into float64 and lose precision.
+	NumberAsNumber NumberEncoding = iota
+	// NumberAsString writes a JSON string, e.g. "12.50".
+	NumberAsString
+)
+
+// DecimalJSONEncoding is how Decimal MarshalJSON writes values.
+// UnmarshalJSON always accepts both numbers and strings.
+var DecimalJSONEncoding = NumberAsNumber
+
+func NewDecimal(value Dec, hasValue bool) Decimal {
+	opt := &Decimal{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeDecimal returns a Decimal containing value.
+func SomeDecimal(value Dec) Decimal {
+	return NewDecimal(value, true)
+}
+
+// NoneDecimal returns a Decimal containing no value.
+func NoneDecimal() Decimal {
+	return Decimal{}
+}
+
+// SetValue performs the conversion.
+func (opt *Decimal) SetValue(value Dec) {
+	(*Option[Dec])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Decimal) Clear() {
+	(*Option[Dec])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Decimal) Take() Decimal {
+	return Decimal((*Option[Dec])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Decimal) Replace(value Dec) Decimal {
+	return Decimal((*Option[Dec])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Decimal) GetOrInsert(value Dec) Dec {
+	return (*Option[Dec])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Decimal) GetOrInsertWith(fn func() Dec) Dec {
+	return (*Option[Dec])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Decimal) Unwrap() (Dec, bool) {
+	return Option[Dec](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Decimal) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Decimal) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Decimal) UnwrapOr(def Dec) Dec {
+	return Option[Dec](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Decimal) UnwrapOrElse(fn func() Dec) Dec {
+	return Option[Dec](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Decimal) UnwrapOrDefault() Dec {
+	return Option[Dec](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Decimal) UnwrapOrPanic() Dec {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Decimal")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Decimal) Or(optb Decimal) Decimal {
+	return Decimal(Option[Dec](opt).Or(Option[Dec](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Decimal) OrElse(fn func() Decimal) Decimal {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Decimal) And(optb Decimal) Decimal {
+	return Decimal(Option[Dec](opt).And(Option[Dec](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Decimal) Xor(optb Decimal) Decimal {
+	return Decimal(Option[Dec](opt).Xor(Option[Dec](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Decimal) Filter(pred func(Dec) bool) Decimal {
+	return Decimal(Option[Dec](opt).Filter(pred))
+}
+
+func (opt Decimal) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Decimal) getValue() Dec {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Decimal) String() string {
+	return Option[Dec](opt).String()
+}
+
+// Add returns opt + optb, or None if either is None.
+func (opt Decimal) Add(optb Decimal) Decimal {
+	if !opt.getHasValue() || !optb.getHasValue() {
+		return Decimal{}
+	}
+	return SomeDecimal(opt.getValue().Add(optb.getValue()))
+}
+
+// Sub returns opt - optb, or None if either is None.
+func (opt Decimal) Sub(optb Decimal) Decimal {
+	if !opt.getHasValue() || !optb.getHasValue() {
+		return Decimal{}
+	}
+	return SomeDecimal(opt.getValue().Sub(optb.getValue()))
+}
+
+// Mul returns opt × optb, or None if either is None.
+func (opt Decimal) Mul(optb Decimal) Decimal {
+	if !opt.getHasValue() || !optb.getHasValue() {
+		return Decimal{}
+	}
+	return SomeDecimal(opt.getValue().Mul(optb.getValue()))
+}
+
+// Round returns the contained value rounded to scale with mode, or None.
+func (opt Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	if !opt.getHasValue() {
+		return Decimal{}
+	}
+	return SomeDecimal(opt.getValue().Round(scale, mode))
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Decimal) MarshalJSON() ([]byte, error) {
+	if !opt.getHasValue() {
+		return []byte("null"), nil
+	}
+
+	if DecimalJSONEncoding == NumberAsString {
+		return []byte(`"` + opt.getValue().String() + `"`), nil
+	}
+	return []byte(opt.getValue().String()), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || data == nil {
+		opt.value, opt.hasValue = Dec{}, false
+		return nil
+	}
+
+	s := string(data)
+	if data[0] == '"' {
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			opt.hasValue = false
+			return errors.WithStack(err)
+		}
+	}
+
+	value, err := ParseDec(s)
+	if err != nil {
+		opt.hasValue = false
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface. Numeric columns are read from
+// the text the driver hands over, without going through float64.
+func (opt *Decimal) Scan(src interface{}) error {
+	if src == nil {
+		opt.value, opt.hasValue = Dec{}, false
+		return nil
+	}
+
+	var value Dec
+	err := value.Scan(src)
+	if err != nil {
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. The value is sent as exact
+// decimal text.
+func (opt Decimal) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+	return opt.getValue().Value()
+}
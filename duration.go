@@ -0,0 +1,614 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// Duration is a nullable Interval, for interval columns.
+type Duration Option[Interval]
+
+// DurationFormat selects how Duration MarshalJSON writes values.
+type DurationFormat int
+
+const (
+	// DurationAsISO8601 writes ISO 8601 durations such as "P1M2DT3H30M".
+	DurationAsISO8601 DurationFormat = iota
+	// DurationAsGo writes time.Duration strings such as "1h30m0s". Intervals
+	// with months or days can't be written this way, and fail to marshal.
+	DurationAsGo
+)
+
+// DurationJSONFormat is how Duration MarshalJSON writes values.
+// UnmarshalJSON accepts both forms.
+var DurationJSONFormat = DurationAsISO8601
+
+func NewDuration(value Interval, hasValue bool) Duration {
+	opt := &Duration{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeDuration returns a Duration containing value.
+func SomeDuration(value Interval) Duration {
+	return NewDuration(value, true)
+}
+
+// NoneDuration returns a Duration containing no value.
+func NoneDuration() Duration {
+	return Duration{}
+}
+
+// SetValue performs the conversion.
+func (opt *Duration) SetValue(value Interval) {
+	(*Option[Interval])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Duration) Clear() {
+	(*Option[Interval])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Duration) Take() Duration {
+	return Duration((*Option[Interval])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Duration) Replace(value Interval) Duration {
+	return Duration((*Option[Interval])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Duration) GetOrInsert(value Interval) Interval {
+	return (*Option[Interval])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Duration) GetOrInsertWith(fn func() Interval) Interval {
+	return (*Option[Interval])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Duration) Unwrap() (Interval, bool) {
+	return Option[Interval](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Duration) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Duration) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Duration) UnwrapOr(def Interval) Interval {
+	return Option[Interval](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Duration) UnwrapOrElse(fn func() Interval) Interval {
+	return Option[Interval](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Duration) UnwrapOrDefault() Interval {
+	return Option[Interval](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Duration) UnwrapOrPanic() Interval {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Duration")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Duration) Or(optb Duration) Duration {
+	return Duration(Option[Interval](opt).Or(Option[Interval](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Duration) OrElse(fn func() Duration) Duration {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Duration) And(optb Duration) Duration {
+	return Duration(Option[Interval](opt).And(Option[Interval](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Duration) Xor(optb Duration) Duration {
+	return Duration(Option[Interval](opt).Xor(Option[Interval](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Duration) Filter(pred func(Interval) bool) Duration {
+	return Duration(Option[Interval](opt).Filter(pred))
+}
+
+func (opt Duration) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Duration) getValue() Interval {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Duration) String() string {
+	return Option[Interval](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Duration) MarshalJSON() ([]byte, error) {
+	if !opt.getHasValue() {
+		return []byte("null"), nil
+	}
+
+	value := opt.getValue()
+	if DurationJSONFormat == DurationAsGo {
+		d, ok := value.Duration()
+		if !ok {
+			return nil, errors.Errorf("null: Duration %s has months or days, which time.Duration can't represent", value)
+		}
+		return json.Marshal(d.String())
+	}
+	return json.Marshal(value.ISO8601())
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. It accepts ISO
+// 8601 durations and time.Duration strings.
+func (opt *Duration) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || data == nil {
+		opt.value, opt.hasValue = Interval{}, false
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		opt.hasValue = false
+		return errors.WithStack(err)
+	}
+
+	var value Interval
+	if strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P") {
+		value, err = ParseInterval(s)
+	} else {
+		var d time.Duration
+		d, err = time.ParseDuration(s)
+		value = IntervalOf(d)
+	}
+	if err != nil {
+		opt.hasValue = false
+		return errors.WithStack(err)
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface. It accepts interval text in any
+// of the output styles ParseInterval does, and an int64 of nanoseconds.
+func (opt *Duration) Scan(src interface{}) error {
+	if src == nil {
+		opt.value, opt.hasValue = Interval{}, false
+		return nil
+	}
+
+	var value Interval
+	err := value.Scan(src)
+	if err != nil {
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. The value is sent as an
+// interval literal in the Postgres output style.
+func (opt Duration) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+	return opt.getValue().Value()
+}
+
+// Interval is a span of time split the way Postgres splits interval values.
+// Months and days are kept apart from the time of day, as their length
+// depends on the calendar: one month added to January 31 is February 29 or
+// 28, and one day across a DST change is 23 or 25 hours.
+type Interval struct {
+	Months int
+	Days   int
+	Time   time.Duration
+}
+
+// IntervalOf returns d as an Interval with no months or days.
+func IntervalOf(d time.Duration) Interval {
+	return Interval{Time: d}
+}
+
+// Duration returns the interval as a time.Duration, and whether it has no
+// months or days, i.e. whether the conversion is exact.
+func (i Interval) Duration() (time.Duration, bool) {
+	return i.Time, i.Months == 0 && i.Days == 0
+}
+
+// AddTo returns t moved by the interval, adding months and days on the
+// calendar of t's location and then the time.
+func (i Interval) AddTo(t time.Time) time.Time {
+	return t.AddDate(0, i.Months, i.Days).Add(i.Time)
+}
+
+// IsZero returns true if the interval is empty.
+func (i Interval) IsZero() bool {
+	return i == Interval{}
+}
+
+// String returns the interval in the Postgres output style, e.g.
+// "1 year 2 mons -3 days +04:05:06.5", which Postgres also accepts as input.
+func (i Interval) String() string {
+	var parts []string
+	negative := false
+	unit := func(n int, singular, plural string) {
+		if n == 0 {
+			return
+		}
+		name := plural
+		if n == 1 {
+			name = singular
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", n, name))
+		negative = negative || n < 0
+	}
+	unit(i.Months/12, "year", "years")
+	unit(i.Months%12, "mon", "mons")
+	unit(i.Days, "day", "days")
+
+	if i.Time != 0 || len(parts) == 0 {
+		clock := formatClock(i.Time)
+		if negative && i.Time > 0 {
+			clock = "+" + clock
+		}
+		parts = append(parts, clock)
+	}
+	return strings.Join(parts, " ")
+}
+
+// ISO8601 returns the interval as an ISO 8601 duration, e.g.
+// "P1Y2M3DT4H5M6.5S".
+func (i Interval) ISO8601() string {
+	if i.IsZero() {
+		return "PT0S"
+	}
+
+	s := "P"
+	if years := i.Months / 12; years != 0 {
+		s += strconv.Itoa(years) + "Y"
+	}
+	if months := i.Months % 12; months != 0 {
+		s += strconv.Itoa(months) + "M"
+	}
+	if i.Days != 0 {
+		s += strconv.Itoa(i.Days) + "D"
+	}
+	if i.Time == 0 {
+		return s
+	}
+
+	s += "T"
+	if hours := i.Time / time.Hour; hours != 0 {
+		s += strconv.FormatInt(int64(hours), 10) + "H"
+	}
+	if minutes := i.Time % time.Hour / time.Minute; minutes != 0 {
+		s += strconv.FormatInt(int64(minutes), 10) + "M"
+	}
+	if seconds := i.Time % time.Minute; seconds != 0 {
+		s += strconv.FormatFloat(seconds.Seconds(), 'f', -1, 64) + "S"
+	}
+	return s
+}
+
+// Scan implements the sql Scanner interface, so Option[Interval] and
+// Patch[Interval] can be scanned. It accepts interval text in any of the
+// output styles ParseInterval does, and an int64 of nanoseconds. NULL is an
+// error; use Duration for nullable columns.
+func (i *Interval) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return errors.New("null: cannot scan NULL into Interval")
+	case int64:
+		*i = IntervalOf(time.Duration(v))
+		return nil
+	}
+
+	var s string
+	err := internal.ConvertAssign(&s, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	value, err := ParseInterval(s)
+	if err != nil {
+		return err
+	}
+	*i = value
+	return nil
+}
+
+// Value implements the driver Valuer interface, so Option[Interval] and
+// Patch[Interval] can be sent. The value is sent as an interval literal in
+// the Postgres output style.
+func (i Interval) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// formatClock formats d as "[-]HH:MM:SS[.fraction]".
+func formatClock(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	s := fmt.Sprintf("%s%02d:%02d:%02d", sign, d/time.Hour, d%time.Hour/time.Minute, d%time.Minute/time.Second)
+	if fraction := d % time.Second; fraction != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%09d", fraction), "0")
+	}
+	return s
+}
+
+// ParseInterval parses interval text in the postgres ("1 year 2 mons 3 days
+// 04:05:06"), sql_standard ("1-2 3 4:05:06") and iso_8601
+// ("P1Y2M3DT4H5M6S") output styles of Postgres.
+func ParseInterval(s string) (Interval, error) {
+	text := strings.TrimSpace(s)
+	var i Interval
+	var ok bool
+	switch {
+	case strings.HasPrefix(text, "P") || strings.HasPrefix(text, "-P"):
+		i, ok = parseISO8601(text)
+	case strings.IndexFunc(text, unicode.IsLetter) >= 0:
+		i, ok = parsePostgresInterval(text)
+	default:
+		i, ok = parseSQLStandardInterval(text)
+	}
+	if !ok {
+		return Interval{}, errors.Errorf("null: invalid interval %q", s)
+	}
+	return i, nil
+}
+
+func parsePostgresInterval(s string) (Interval, bool) {
+	var i Interval
+	fields := strings.Fields(s)
+	for n := 0; n < len(fields); n++ {
+		if strings.IndexByte(fields[n], ':') >= 0 {
+			d, ok := parseClock(fields[n])
+			if !ok {
+				return i, false
+			}
+			i.Time += d
+			continue
+		}
+
+		if n+1 == len(fields) {
+			return i, false
+		}
+		value, err := strconv.Atoi(fields[n])
+		if err != nil {
+			return i, false
+		}
+		n++
+		switch strings.TrimSuffix(fields[n], "s") {
+		case "year":
+			i.Months += 12 * value
+		case "mon":
+			i.Months += value
+		case "day":
+			i.Days += value
+		default:
+			return i, false
+		}
+	}
+	return i, len(fields) > 0
+}
+
+func parseSQLStandardInterval(s string) (Interval, bool) {
+	var i Interval
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return i, false
+	}
+
+	// A leading minus sign applies to every field when no other field has a
+	// sign of its own, so "-1 2:03:04" is -1 days -2:03:04.
+	negateAll := fields[0][0] == '-'
+	for _, field := range fields[1:] {
+		if field[0] == '-' || field[0] == '+' {
+			negateAll = false
+		}
+	}
+	if negateAll {
+		fields[0] = fields[0][1:]
+	}
+
+	for _, field := range fields {
+		if field == "" {
+			return i, false
+		}
+		sign := 1
+		unsigned := field
+		if field[0] == '-' || field[0] == '+' {
+			if field[0] == '-' {
+				sign = -1
+			}
+			unsigned = field[1:]
+		}
+		if unsigned == "" {
+			return i, false
+		}
+
+		switch {
+		case strings.IndexByte(field, ':') >= 0:
+			d, ok := parseClock(field)
+			if !ok {
+				return i, false
+			}
+			i.Time += d
+		case strings.IndexByte(unsigned, '-') >= 0:
+			parts := strings.SplitN(unsigned, "-", 2)
+			years, err1 := strconv.Atoi(parts[0])
+			months, err2 := strconv.Atoi(parts[1])
+			if err1 != nil || err2 != nil || !isDigits(parts[0]) || !isDigits(parts[1]) {
+				return i, false
+			}
+			i.Months += sign * (12*years + months)
+		default:
+			days, err := strconv.Atoi(unsigned)
+			if err != nil || !isDigits(unsigned) {
+				return i, false
+			}
+			i.Days += sign * days
+		}
+	}
+
+	if negateAll {
+		i.Months, i.Days, i.Time = -i.Months, -i.Days, -i.Time
+	}
+	return i, true
+}
+
+// parseClock parses "[+-]H:MM[:SS[.fraction]]", where H may exceed 24.
+func parseClock(s string) (time.Duration, bool) {
+	sign := time.Duration(1)
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		if s[0] == '-' {
+			sign = -1
+		}
+		s = s[1:]
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return 0, false
+	}
+	var fraction time.Duration
+	if i := strings.IndexByte(parts[len(parts)-1], '.'); i >= 0 && len(parts) == 3 {
+		digits := parts[2][i+1:]
+		if digits == "" || len(digits) > 9 || !isDigits(digits) {
+			return 0, false
+		}
+		n, _ := strconv.Atoi(digits + strings.Repeat("0", 9-len(digits)))
+		fraction = time.Duration(n)
+		parts[2] = parts[2][:i]
+	}
+
+	var d time.Duration
+	for n, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if n == len(parts) {
+			break
+		}
+		if parts[n] == "" || !isDigits(parts[n]) {
+			return 0, false
+		}
+		value, err := strconv.ParseInt(parts[n], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		d += time.Duration(value) * unit
+	}
+	return sign * (d + fraction), true
+}
+
+func parseISO8601(s string) (Interval, bool) {
+	var i Interval
+	sign := 1
+	if s[0] == '-' {
+		sign, s = -1, s[1:]
+	}
+	s = s[1:] // P
+	if s == "" {
+		return i, false
+	}
+
+	// At least one component is required, and the T must be followed by one.
+	inTime, fields := false, 0
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return i, false
+			}
+			inTime, s = true, s[1:]
+			continue
+		}
+		fields++
+
+		end := strings.IndexFunc(s, unicode.IsLetter)
+		if end <= 0 {
+			return i, false
+		}
+		number, designator := s[:end], s[end]
+		s = s[end+1:]
+
+		value, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return i, false
+		}
+		whole := int(value)
+		if float64(whole) != value && !(inTime && designator == 'S') {
+			return i, false
+		}
+
+		switch {
+		case !inTime && designator == 'Y':
+			i.Months += 12 * whole
+		case !inTime && designator == 'M':
+			i.Months += whole
+		case !inTime && designator == 'W':
+			i.Days += 7 * whole
+		case !inTime && designator == 'D':
+			i.Days += whole
+		case inTime && designator == 'H':
+			i.Time += time.Duration(whole) * time.Hour
+		case inTime && designator == 'M':
+			i.Time += time.Duration(whole) * time.Minute
+		case inTime && designator == 'S':
+			i.Time += time.Duration(math.Round(value * float64(time.Second)))
+		default:
+			return i, false
+		}
+	}
+
+	i.Months, i.Days, i.Time = sign*i.Months, sign*i.Days, time.Duration(sign)*i.Time
+	return i, fields > 0
+}
@@ -0,0 +1,128 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Interval
+	}{
+		// postgres output style
+		{"00:00:00", Interval{}},
+		{"1 day", Interval{Days: 1}},
+		{"1 year 2 mons 3 days 04:05:06", Interval{Months: 14, Days: 3, Time: 4*time.Hour + 5*time.Minute + 6*time.Second}},
+		{"-1 years -2 mons", Interval{Months: -14}},
+		{"-1 days +02:03:00", Interval{Days: -1, Time: 2*time.Hour + 3*time.Minute}},
+		{"1 mon -3 days -04:05:06.5", Interval{Months: 1, Days: -3, Time: -(4*time.Hour + 5*time.Minute + 6500*time.Millisecond)}},
+		{"100:00:00", Interval{Time: 100 * time.Hour}},
+		{"00:00:00.000001", Interval{Time: time.Microsecond}},
+		{" 2 days ", Interval{Days: 2}},
+
+		// sql_standard output style
+		{"0", Interval{}},
+		{"1-2", Interval{Months: 14}},
+		{"1-2 3 4:05:06", Interval{Months: 14, Days: 3, Time: 4*time.Hour + 5*time.Minute + 6*time.Second}},
+		{"3 4:05:06", Interval{Days: 3, Time: 4*time.Hour + 5*time.Minute + 6*time.Second}},
+		{"4:05:06.25", Interval{Time: 4*time.Hour + 5*time.Minute + 6250*time.Millisecond}},
+		{"-1 2:03:04", Interval{Days: -1, Time: -(2*time.Hour + 3*time.Minute + 4*time.Second)}},
+		{"-1-2", Interval{Months: -14}},
+		{"-0-2", Interval{Months: -2}},
+		{"-1-2 +3 -4:05:06", Interval{Months: -14, Days: 3, Time: -(4*time.Hour + 5*time.Minute + 6*time.Second)}},
+		{"+1-2 -3 +4:05:06", Interval{Months: 14, Days: -3, Time: 4*time.Hour + 5*time.Minute + 6*time.Second}},
+
+		// iso_8601 output style
+		{"PT0S", Interval{}},
+		{"P1Y2M3DT4H5M6S", Interval{Months: 14, Days: 3, Time: 4*time.Hour + 5*time.Minute + 6*time.Second}},
+		{"P-1Y-2M3DT-4H-5M-6S", Interval{Months: -14, Days: 3, Time: -(4*time.Hour + 5*time.Minute + 6*time.Second)}},
+		{"PT1.5S", Interval{Time: 1500 * time.Millisecond}},
+		{"PT-0.000001S", Interval{Time: -time.Microsecond}},
+		{"P2W", Interval{Days: 14}},
+		{"P1M", Interval{Months: 1}},
+		{"PT1M", Interval{Time: time.Minute}},
+		{"-P1DT1H", Interval{Days: -1, Time: -time.Hour}},
+	}
+	for _, tt := range tests {
+		got, err := ParseInterval(tt.in)
+		if err != nil {
+			t.Errorf("ParseInterval(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseInterval(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseIntervalInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		" ",
+		"-",
+		"+",
+		"- 1",
+		"1 -",
+		"P",
+		"-P",
+		"PT",
+		"P1DT",
+		"P1",
+		"P1H",
+		"PT1D",
+		"PTT1S",
+		"P1.5Y",
+		"PxS",
+		"1 fortnight",
+		"1 year 2",
+		"year",
+		"1:2:3:4",
+		"1:",
+		"::",
+		"00:00:00.",
+		"00:00:00.1234567890",
+		"1-",
+		"1-x",
+		"x",
+		"1 2 x",
+	}
+	for _, in := range tests {
+		if got, err := ParseInterval(in); err == nil {
+			t.Errorf("ParseInterval(%q) = %+v, want error", in, got)
+		}
+	}
+}
+
+func TestIntervalString(t *testing.T) {
+	tests := []struct {
+		in   Interval
+		want string
+		iso  string
+	}{
+		{Interval{}, "00:00:00", "PT0S"},
+		{Interval{Days: 1}, "1 day", "P1D"},
+		{Interval{Days: -1}, "-1 days", "P-1D"},
+		{Interval{Months: 1}, "1 mon", "P1M"},
+		{Interval{Months: 14, Days: 3, Time: 4*time.Hour + 5*time.Minute + 6*time.Second}, "1 year 2 mons 3 days 04:05:06", "P1Y2M3DT4H5M6S"},
+		{Interval{Days: -1, Time: 2*time.Hour + 3*time.Minute}, "-1 days +02:03:00", "P-1DT2H3M"},
+		{Interval{Days: -1, Time: -2 * time.Hour}, "-1 days -02:00:00", "P-1DT-2H"},
+		{Interval{Time: 1500 * time.Millisecond}, "00:00:01.5", "PT1.5S"},
+		{Interval{Time: 100 * time.Hour}, "100:00:00", "PT100H"},
+	}
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.in, got, tt.want)
+		}
+		if got := tt.in.ISO8601(); got != tt.iso {
+			t.Errorf("%+v.ISO8601() = %q, want %q", tt.in, got, tt.iso)
+		}
+
+		for _, text := range []string{tt.in.String(), tt.in.ISO8601()} {
+			back, err := ParseInterval(text)
+			if err != nil || back != tt.in {
+				t.Errorf("ParseInterval(%q) = %+v, %v, want %+v", text, back, err, tt.in)
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package null
+
+import (
+	"database/sql/driver"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+type Float32 Option[float32]
+
+func NewFloat32(value float32, hasValue bool) Float32 {
+	opt := &Float32{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeFloat32 returns a Float32 containing value.
+func SomeFloat32(value float32) Float32 {
+	return NewFloat32(value, true)
+}
+
+// NoneFloat32 returns a Float32 containing no value.
+func NoneFloat32() Float32 {
+	return Float32{}
+}
+
+// SetValue performs the conversion.
+func (opt *Float32) SetValue(value float32) {
+	(*Option[float32])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Float32) Clear() {
+	(*Option[float32])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Float32) Take() Float32 {
+	return Float32((*Option[float32])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Float32) Replace(value float32) Float32 {
+	return Float32((*Option[float32])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Float32) GetOrInsert(value float32) float32 {
+	return (*Option[float32])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Float32) GetOrInsertWith(fn func() float32) float32 {
+	return (*Option[float32])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Float32) Unwrap() (float32, bool) {
+	return Option[float32](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Float32) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Float32) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Float32) UnwrapOr(def float32) float32 {
+	return Option[float32](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Float32) UnwrapOrElse(fn func() float32) float32 {
+	return Option[float32](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Float32) UnwrapOrDefault() float32 {
+	return Option[float32](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Float32) UnwrapOrPanic() float32 {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Float32")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Float32) Or(optb Float32) Float32 {
+	return Float32(Option[float32](opt).Or(Option[float32](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Float32) OrElse(fn func() Float32) Float32 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Float32) And(optb Float32) Float32 {
+	return Float32(Option[float32](opt).And(Option[float32](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Float32) Xor(optb Float32) Float32 {
+	return Float32(Option[float32](opt).Xor(Option[float32](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Float32) Filter(pred func(float32) bool) Float32 {
+	return Float32(Option[float32](opt).Filter(pred))
+}
+
+func (opt Float32) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Float32) getValue() float32 {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Float32) String() string {
+	return Option[float32](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface. The value is written
+// in the shortest form that parses back to the same float32.
+func (opt Float32) MarshalJSON() ([]byte, error) {
+	return Option[float32](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Float32) UnmarshalJSON(data []byte) error {
+	return (*Option[float32])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface. The source is parsed with 32-bit
+// precision, so a float4 column handed over as float64 comes back as the
+// float32 it was stored as.
+func (opt *Float32) Scan(src interface{}) error {
+	return (*Option[float32])(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface. The value is widened to the
+// float64 nearest its shortest decimal form, so 0.1 is sent as 0.1 rather
+// than as 0.10000000149011612.
+func (opt Float32) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+
+	s := strconv.FormatFloat(float64(opt.getValue()), 'g', -1, 32)
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return value, nil
+}
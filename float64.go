@@ -4,17 +4,30 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/json"
-	"fmt"
+	"math"
 
 	"github.com/pkg/errors"
+)
+
+type Float64 Option[float64]
 
-	"github.com/Gurpartap/null/internal"
+// NonFinitePolicy selects how MarshalJSON encodes NaN and ±Infinity, which
+// JSON numbers can't represent.
+type NonFinitePolicy int
+
+const (
+	// NonFiniteError makes MarshalJSON fail, like encoding/json does.
+	NonFiniteError NonFinitePolicy = iota
+	// NonFiniteNull encodes the value as null.
+	NonFiniteNull
+	// NonFiniteString encodes the value as "NaN", "Infinity" or "-Infinity",
+	// the spellings Postgres uses.
+	NonFiniteString
 )
 
-type Float64 struct {
-	hasValue bool
-	value    float64
-}
+// Float64NonFinitePolicy is how Float64 MarshalJSON encodes NaN and
+// ±Infinity. UnmarshalJSON always accepts the string forms.
+var Float64NonFinitePolicy = NonFiniteError
 
 func NewFloat64(value float64, hasValue bool) Float64 {
 	opt := &Float64{}
@@ -24,41 +37,78 @@ func NewFloat64(value float64, hasValue bool) Float64 {
 	return *opt
 }
 
+// SomeFloat64 returns a Float64 containing value.
+func SomeFloat64(value float64) Float64 {
+	return NewFloat64(value, true)
+}
+
+// NoneFloat64 returns a Float64 containing no value.
+func NoneFloat64() Float64 {
+	return Float64{}
+}
+
 // SetValue performs the conversion.
 func (opt *Float64) SetValue(value float64) {
-	opt.value = value
-	opt.hasValue = true
+	(*Option[float64])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Float64) Clear() {
+	(*Option[float64])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Float64) Take() Float64 {
+	return Float64((*Option[float64])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Float64) Replace(value float64) Float64 {
+	return Float64((*Option[float64])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Float64) GetOrInsert(value float64) float64 {
+	return (*Option[float64])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Float64) GetOrInsertWith(fn func() float64) float64 {
+	return (*Option[float64])(opt).GetOrInsertWith(fn)
 }
 
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt Float64) Unwrap() (float64, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[float64](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Float64) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Float64) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt Float64) UnwrapOr(def float64) float64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[float64](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt Float64) UnwrapOrElse(fn func() float64) float64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[float64](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt Float64) UnwrapOrDefault() float64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return 0
+	return Option[float64](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -69,6 +119,37 @@ func (opt Float64) UnwrapOrPanic() float64 {
 	panic("unable to unwrap Float64")
 }
 
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Float64) Or(optb Float64) Float64 {
+	return Float64(Option[float64](opt).Or(Option[float64](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Float64) OrElse(fn func() Float64) Float64 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Float64) And(optb Float64) Float64 {
+	return Float64(Option[float64](opt).And(Option[float64](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Float64) Xor(optb Float64) Float64 {
+	return Float64(Option[float64](opt).Xor(Option[float64](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Float64) Filter(pred func(float64) bool) Float64 {
+	return Float64(Option[float64](opt).Filter(pred))
+}
+
 func (opt Float64) getHasValue() bool {
 	return opt.hasValue
 }
@@ -79,10 +160,7 @@ func (opt Float64) getValue() float64 {
 
 // String conforms to fmt Stringer interface.
 func (opt Float64) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[float64](opt).String()
 }
 
 // MarshalJSON implements the json Marshaler interface.
@@ -90,47 +168,53 @@ func (opt Float64) MarshalJSON() ([]byte, error) {
 	if !opt.getHasValue() {
 		return []byte("null"), nil
 	}
-	return json.Marshal(opt.getValue())
-}
 
-// UnmarshalJSON implements the json Unmarshaler interface.
-func (opt *Float64) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) || data == nil {
-		opt.value, opt.hasValue = 0, false
-		return nil
+	value := opt.getValue()
+	if !math.IsNaN(value) && !math.IsInf(value, 0) {
+		return json.Marshal(value)
 	}
 
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
-		opt.hasValue = false
-		return errors.WithStack(err)
+	switch Float64NonFinitePolicy {
+	case NonFiniteNull:
+		return []byte("null"), nil
+	case NonFiniteString:
+		switch {
+		case math.IsNaN(value):
+			return []byte(`"NaN"`), nil
+		case math.IsInf(value, 1):
+			return []byte(`"Infinity"`), nil
+		default:
+			return []byte(`"-Infinity"`), nil
+		}
 	}
-	opt.hasValue = true
-
-	return nil
+	return nil, errors.Errorf("null: unsupported Float64 value %v in JSON", value)
 }
 
-// Scan implements the sql Scanner interface.
-func (opt *Float64) Scan(src interface{}) error {
-	if src == nil {
-		opt.value, opt.hasValue = 0, false
+// UnmarshalJSON implements the json Unmarshaler interface. Besides numbers,
+// it accepts the strings "NaN", "Infinity" and "-Infinity".
+func (opt *Float64) UnmarshalJSON(data []byte) error {
+	switch {
+	case bytes.Equal(data, []byte(`"NaN"`)):
+		opt.SetValue(math.NaN())
+		return nil
+	case bytes.Equal(data, []byte(`"Infinity"`)):
+		opt.SetValue(math.Inf(1))
+		return nil
+	case bytes.Equal(data, []byte(`"-Infinity"`)):
+		opt.SetValue(math.Inf(-1))
 		return nil
 	}
+	return (*Option[float64])(opt).UnmarshalJSON(data)
+}
 
-	var value float64
-	err := internal.ConvertAssign(&value, src)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	opt.SetValue(value)
-
-	return nil
+// Scan implements the sql Scanner interface. The 'NaN', 'Infinity' and
+// '-Infinity' text Postgres returns for double precision and numeric columns
+// is parsed into the matching special values.
+func (opt *Float64) Scan(src interface{}) error {
+	return (*Option[float64])(opt).Scan(src)
 }
 
 // Value implements the driver Valuer interface.
 func (opt Float64) Value() (driver.Value, error) {
-	if !opt.getHasValue() {
-		return nil, nil
-	}
-	return float64(opt.getValue()), nil
+	return Option[float64](opt).Value()
 }
@@ -0,0 +1,5 @@
+module github.com/Gurpartap/null
+
+go 1.18
+
+require github.com/pkg/errors v0.9.1
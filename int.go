@@ -0,0 +1,159 @@
+package null
+
+import "database/sql/driver"
+
+type Int Option[int]
+
+func NewInt(value int, hasValue bool) Int {
+	opt := &Int{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeInt returns a Int containing value.
+func SomeInt(value int) Int {
+	return NewInt(value, true)
+}
+
+// NoneInt returns a Int containing no value.
+func NoneInt() Int {
+	return Int{}
+}
+
+// SetValue performs the conversion.
+func (opt *Int) SetValue(value int) {
+	(*Option[int])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Int) Clear() {
+	(*Option[int])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Int) Take() Int {
+	return Int((*Option[int])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Int) Replace(value int) Int {
+	return Int((*Option[int])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Int) GetOrInsert(value int) int {
+	return (*Option[int])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Int) GetOrInsertWith(fn func() int) int {
+	return (*Option[int])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Int) Unwrap() (int, bool) {
+	return Option[int](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Int) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Int) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Int) UnwrapOr(def int) int {
+	return Option[int](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Int) UnwrapOrElse(fn func() int) int {
+	return Option[int](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Int) UnwrapOrDefault() int {
+	return Option[int](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Int) UnwrapOrPanic() int {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Int")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Int) Or(optb Int) Int {
+	return Int(Option[int](opt).Or(Option[int](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Int) OrElse(fn func() Int) Int {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Int) And(optb Int) Int {
+	return Int(Option[int](opt).And(Option[int](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Int) Xor(optb Int) Int {
+	return Int(Option[int](opt).Xor(Option[int](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Int) Filter(pred func(int) bool) Int {
+	return Int(Option[int](opt).Filter(pred))
+}
+
+func (opt Int) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Int) getValue() int {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Int) String() string {
+	return Option[int](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Int) MarshalJSON() ([]byte, error) {
+	return Option[int](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Int) UnmarshalJSON(data []byte) error {
+	return (*Option[int])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *Int) Scan(src interface{}) error {
+	return (*Option[int])(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface.
+func (opt Int) Value() (driver.Value, error) {
+	return Option[int](opt).Value()
+}
@@ -1,20 +1,8 @@
 package null
 
-import (
-	"bytes"
-	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+import "database/sql/driver"
 
-	"github.com/pkg/errors"
-
-	"github.com/Gurpartap/null/internal"
-)
-
-type Int16 struct {
-	hasValue bool
-	value    int16
-}
+type Int16 Option[int16]
 
 func NewInt16(value int16, hasValue bool) Int16 {
 	opt := &Int16{}
@@ -24,41 +12,78 @@ func NewInt16(value int16, hasValue bool) Int16 {
 	return *opt
 }
 
+// SomeInt16 returns a Int16 containing value.
+func SomeInt16(value int16) Int16 {
+	return NewInt16(value, true)
+}
+
+// NoneInt16 returns a Int16 containing no value.
+func NoneInt16() Int16 {
+	return Int16{}
+}
+
 // SetValue performs the conversion.
 func (opt *Int16) SetValue(value int16) {
-	opt.value = value
-	opt.hasValue = true
+	(*Option[int16])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Int16) Clear() {
+	(*Option[int16])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Int16) Take() Int16 {
+	return Int16((*Option[int16])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Int16) Replace(value int16) Int16 {
+	return Int16((*Option[int16])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Int16) GetOrInsert(value int16) int16 {
+	return (*Option[int16])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Int16) GetOrInsertWith(fn func() int16) int16 {
+	return (*Option[int16])(opt).GetOrInsertWith(fn)
 }
 
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt Int16) Unwrap() (int16, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[int16](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Int16) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Int16) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt Int16) UnwrapOr(def int16) int16 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[int16](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt Int16) UnwrapOrElse(fn func() int16) int16 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[int16](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt Int16) UnwrapOrDefault() int16 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return 0
+	return Option[int16](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -66,7 +91,38 @@ func (opt Int16) UnwrapOrPanic() int16 {
 	if opt.getHasValue() {
 		return opt.getValue()
 	}
-	panic("unable to unwrap Int64")
+	panic("unable to unwrap Int16")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Int16) Or(optb Int16) Int16 {
+	return Int16(Option[int16](opt).Or(Option[int16](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Int16) OrElse(fn func() Int16) Int16 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Int16) And(optb Int16) Int16 {
+	return Int16(Option[int16](opt).And(Option[int16](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Int16) Xor(optb Int16) Int16 {
+	return Int16(Option[int16](opt).Xor(Option[int16](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Int16) Filter(pred func(int16) bool) Int16 {
+	return Int16(Option[int16](opt).Filter(pred))
 }
 
 func (opt Int16) getHasValue() bool {
@@ -79,58 +135,25 @@ func (opt Int16) getValue() int16 {
 
 // String conforms to fmt Stringer interface.
 func (opt Int16) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[int16](opt).String()
 }
 
 // MarshalJSON implements the json Marshaler interface.
 func (opt Int16) MarshalJSON() ([]byte, error) {
-	if !opt.getHasValue() {
-		return []byte("null"), nil
-	}
-	return json.Marshal(opt.getValue())
+	return Option[int16](opt).MarshalJSON()
 }
 
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (opt *Int16) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) || data == nil {
-		opt.value, opt.hasValue = 0, false
-		return nil
-	}
-
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
-		opt.hasValue = false
-		return errors.WithStack(err)
-	}
-	opt.hasValue = true
-
-	return nil
+	return (*Option[int16])(opt).UnmarshalJSON(data)
 }
 
 // Scan implements the sql Scanner interface.
 func (opt *Int16) Scan(src interface{}) error {
-	if src == nil {
-		opt.value, opt.hasValue = 0, false
-		return nil
-	}
-
-	var value int16
-	err := internal.ConvertAssign(&value, src)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	opt.SetValue(value)
-
-	return nil
+	return (*Option[int16])(opt).Scan(src)
 }
 
 // Value implements the driver Valuer interface.
 func (opt Int16) Value() (driver.Value, error) {
-	if !opt.getHasValue() {
-		return nil, nil
-	}
-	return int64(opt.getValue()), nil
+	return Option[int16](opt).Value()
 }
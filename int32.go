@@ -0,0 +1,159 @@
+package null
+
+import "database/sql/driver"
+
+type Int32 Option[int32]
+
+func NewInt32(value int32, hasValue bool) Int32 {
+	opt := &Int32{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeInt32 returns a Int32 containing value.
+func SomeInt32(value int32) Int32 {
+	return NewInt32(value, true)
+}
+
+// NoneInt32 returns a Int32 containing no value.
+func NoneInt32() Int32 {
+	return Int32{}
+}
+
+// SetValue performs the conversion.
+func (opt *Int32) SetValue(value int32) {
+	(*Option[int32])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Int32) Clear() {
+	(*Option[int32])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Int32) Take() Int32 {
+	return Int32((*Option[int32])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Int32) Replace(value int32) Int32 {
+	return Int32((*Option[int32])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Int32) GetOrInsert(value int32) int32 {
+	return (*Option[int32])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Int32) GetOrInsertWith(fn func() int32) int32 {
+	return (*Option[int32])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Int32) Unwrap() (int32, bool) {
+	return Option[int32](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Int32) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Int32) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Int32) UnwrapOr(def int32) int32 {
+	return Option[int32](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Int32) UnwrapOrElse(fn func() int32) int32 {
+	return Option[int32](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Int32) UnwrapOrDefault() int32 {
+	return Option[int32](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Int32) UnwrapOrPanic() int32 {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Int32")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Int32) Or(optb Int32) Int32 {
+	return Int32(Option[int32](opt).Or(Option[int32](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Int32) OrElse(fn func() Int32) Int32 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Int32) And(optb Int32) Int32 {
+	return Int32(Option[int32](opt).And(Option[int32](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Int32) Xor(optb Int32) Int32 {
+	return Int32(Option[int32](opt).Xor(Option[int32](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Int32) Filter(pred func(int32) bool) Int32 {
+	return Int32(Option[int32](opt).Filter(pred))
+}
+
+func (opt Int32) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Int32) getValue() int32 {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Int32) String() string {
+	return Option[int32](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Int32) MarshalJSON() ([]byte, error) {
+	return Option[int32](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Int32) UnmarshalJSON(data []byte) error {
+	return (*Option[int32])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *Int32) Scan(src interface{}) error {
+	return (*Option[int32])(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface.
+func (opt Int32) Value() (driver.Value, error) {
+	return Option[int32](opt).Value()
+}
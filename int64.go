@@ -1,20 +1,8 @@
 package null
 
-import (
-	"bytes"
-	"database/sql/driver"
-	"encoding/json"
-	"fmt"
+import "database/sql/driver"
 
-	"github.com/pkg/errors"
-
-	"github.com/Gurpartap/null/internal"
-)
-
-type Int64 struct {
-	hasValue bool
-	value    int64
-}
+type Int64 Option[int64]
 
 func NewInt64(value int64, hasValue bool) Int64 {
 	opt := &Int64{}
@@ -24,41 +12,78 @@ func NewInt64(value int64, hasValue bool) Int64 {
 	return *opt
 }
 
+// SomeInt64 returns a Int64 containing value.
+func SomeInt64(value int64) Int64 {
+	return NewInt64(value, true)
+}
+
+// NoneInt64 returns a Int64 containing no value.
+func NoneInt64() Int64 {
+	return Int64{}
+}
+
 // SetValue performs the conversion.
 func (opt *Int64) SetValue(value int64) {
-	opt.value = value
-	opt.hasValue = true
+	(*Option[int64])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Int64) Clear() {
+	(*Option[int64])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Int64) Take() Int64 {
+	return Int64((*Option[int64])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Int64) Replace(value int64) Int64 {
+	return Int64((*Option[int64])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Int64) GetOrInsert(value int64) int64 {
+	return (*Option[int64])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Int64) GetOrInsertWith(fn func() int64) int64 {
+	return (*Option[int64])(opt).GetOrInsertWith(fn)
 }
 
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt Int64) Unwrap() (int64, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[int64](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Int64) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Int64) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt Int64) UnwrapOr(def int64) int64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[int64](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt Int64) UnwrapOrElse(fn func() int64) int64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[int64](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt Int64) UnwrapOrDefault() int64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return 0
+	return Option[int64](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -69,6 +94,37 @@ func (opt Int64) UnwrapOrPanic() int64 {
 	panic("unable to unwrap Int64")
 }
 
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Int64) Or(optb Int64) Int64 {
+	return Int64(Option[int64](opt).Or(Option[int64](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Int64) OrElse(fn func() Int64) Int64 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Int64) And(optb Int64) Int64 {
+	return Int64(Option[int64](opt).And(Option[int64](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Int64) Xor(optb Int64) Int64 {
+	return Int64(Option[int64](opt).Xor(Option[int64](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Int64) Filter(pred func(int64) bool) Int64 {
+	return Int64(Option[int64](opt).Filter(pred))
+}
+
 func (opt Int64) getHasValue() bool {
 	return opt.hasValue
 }
@@ -79,58 +135,25 @@ func (opt Int64) getValue() int64 {
 
 // String conforms to fmt Stringer interface.
 func (opt Int64) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[int64](opt).String()
 }
 
 // MarshalJSON implements the json Marshaler interface.
 func (opt Int64) MarshalJSON() ([]byte, error) {
-	if !opt.getHasValue() {
-		return []byte("null"), nil
-	}
-	return json.Marshal(opt.getValue())
+	return Option[int64](opt).MarshalJSON()
 }
 
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (opt *Int64) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) || data == nil {
-		opt.value, opt.hasValue = 0, false
-		return nil
-	}
-
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
-		opt.hasValue = false
-		return errors.WithStack(err)
-	}
-	opt.hasValue = true
-
-	return nil
+	return (*Option[int64])(opt).UnmarshalJSON(data)
 }
 
 // Scan implements the sql Scanner interface.
 func (opt *Int64) Scan(src interface{}) error {
-	if src == nil {
-		opt.value, opt.hasValue = 0, false
-		return nil
-	}
-
-	var value int64
-	err := internal.ConvertAssign(&value, src)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	opt.SetValue(value)
-
-	return nil
+	return (*Option[int64])(opt).Scan(src)
 }
 
 // Value implements the driver Valuer interface.
 func (opt Int64) Value() (driver.Value, error) {
-	if !opt.getHasValue() {
-		return nil, nil
-	}
-	return int64(opt.getValue()), nil
+	return Option[int64](opt).Value()
 }
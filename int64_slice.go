@@ -1,10 +1,7 @@
 package null
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
 	"strconv"
 	"strings"
 
@@ -14,10 +11,7 @@ import (
 )
 
 // Int64Slice is a sql scanner interface for using []int64 as postgres nullable arrays.
-type Int64Slice struct {
-	hasValue bool
-	value    []int64
-}
+type Int64Slice Option[[]int64]
 
 func NewInt64Slice(value []int64, hasValue bool) Int64Slice {
 	opt := &Int64Slice{}
@@ -27,41 +21,87 @@ func NewInt64Slice(value []int64, hasValue bool) Int64Slice {
 	return *opt
 }
 
+// SomeInt64Slice returns a Int64Slice containing value.
+func SomeInt64Slice(value []int64) Int64Slice {
+	return NewInt64Slice(value, true)
+}
+
+// NoneInt64Slice returns a Int64Slice containing no value.
+func NoneInt64Slice() Int64Slice {
+	return Int64Slice{}
+}
+
 // SetValue performs the conversion.
 func (opt *Int64Slice) SetValue(value []int64) {
 	opt.value = append(opt.value[0:0], value...)
 	opt.hasValue = true
 }
 
+// Clear sets the optional to None and releases the backing array.
+func (opt *Int64Slice) Clear() {
+	(*Option[[]int64])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Int64Slice) Take() Int64Slice {
+	return Int64Slice((*Option[[]int64])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Int64Slice) Replace(value []int64) Int64Slice {
+	old := opt.Take()
+	opt.SetValue(value)
+	return old
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Int64Slice) GetOrInsert(value []int64) []int64 {
+	if !opt.getHasValue() {
+		opt.SetValue(value)
+	}
+	return opt.getValue()
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Int64Slice) GetOrInsertWith(fn func() []int64) []int64 {
+	if !opt.getHasValue() {
+		opt.SetValue(fn())
+	}
+	return opt.getValue()
+}
+
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt Int64Slice) Unwrap() ([]int64, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[[]int64](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Int64Slice) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Int64Slice) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt Int64Slice) UnwrapOr(def []int64) []int64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[[]int64](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt Int64Slice) UnwrapOrElse(fn func() []int64) []int64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[[]int64](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt Int64Slice) UnwrapOrDefault() []int64 {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return nil
+	return Option[[]int64](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -72,6 +112,37 @@ func (opt Int64Slice) UnwrapOrPanic() []int64 {
 	panic("unable to unwrap Int64Slice")
 }
 
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Int64Slice) Or(optb Int64Slice) Int64Slice {
+	return Int64Slice(Option[[]int64](opt).Or(Option[[]int64](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Int64Slice) OrElse(fn func() Int64Slice) Int64Slice {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Int64Slice) And(optb Int64Slice) Int64Slice {
+	return Int64Slice(Option[[]int64](opt).And(Option[[]int64](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Int64Slice) Xor(optb Int64Slice) Int64Slice {
+	return Int64Slice(Option[[]int64](opt).Xor(Option[[]int64](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Int64Slice) Filter(pred func([]int64) bool) Int64Slice {
+	return Int64Slice(Option[[]int64](opt).Filter(pred))
+}
+
 func (opt Int64Slice) getHasValue() bool {
 	return opt.hasValue
 }
@@ -82,35 +153,17 @@ func (opt Int64Slice) getValue() []int64 {
 
 // String conforms to fmt Stringer interface.
 func (opt Int64Slice) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[[]int64](opt).String()
 }
 
 // MarshalJSON implements the json Marshaler interface.
 func (opt Int64Slice) MarshalJSON() ([]byte, error) {
-	if !opt.getHasValue() {
-		return []byte("null"), nil
-	}
-	return json.Marshal(opt.getValue())
+	return Option[[]int64](opt).MarshalJSON()
 }
 
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (opt *Int64Slice) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) || data == nil {
-		opt.value, opt.hasValue = nil, false
-		return nil
-	}
-
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
-		opt.hasValue = false
-		return errors.WithStack(err)
-	}
-	opt.hasValue = true
-
-	return nil
+	return (*Option[[]int64])(opt).UnmarshalJSON(data)
 }
 
 // Scan implements the sql Scanner interface.
@@ -0,0 +1,159 @@
+package null
+
+import "database/sql/driver"
+
+type Int8 Option[int8]
+
+func NewInt8(value int8, hasValue bool) Int8 {
+	opt := &Int8{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeInt8 returns a Int8 containing value.
+func SomeInt8(value int8) Int8 {
+	return NewInt8(value, true)
+}
+
+// NoneInt8 returns a Int8 containing no value.
+func NoneInt8() Int8 {
+	return Int8{}
+}
+
+// SetValue performs the conversion.
+func (opt *Int8) SetValue(value int8) {
+	(*Option[int8])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Int8) Clear() {
+	(*Option[int8])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Int8) Take() Int8 {
+	return Int8((*Option[int8])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Int8) Replace(value int8) Int8 {
+	return Int8((*Option[int8])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Int8) GetOrInsert(value int8) int8 {
+	return (*Option[int8])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Int8) GetOrInsertWith(fn func() int8) int8 {
+	return (*Option[int8])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Int8) Unwrap() (int8, bool) {
+	return Option[int8](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Int8) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Int8) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Int8) UnwrapOr(def int8) int8 {
+	return Option[int8](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Int8) UnwrapOrElse(fn func() int8) int8 {
+	return Option[int8](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Int8) UnwrapOrDefault() int8 {
+	return Option[int8](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Int8) UnwrapOrPanic() int8 {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Int8")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Int8) Or(optb Int8) Int8 {
+	return Int8(Option[int8](opt).Or(Option[int8](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Int8) OrElse(fn func() Int8) Int8 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Int8) And(optb Int8) Int8 {
+	return Int8(Option[int8](opt).And(Option[int8](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Int8) Xor(optb Int8) Int8 {
+	return Int8(Option[int8](opt).Xor(Option[int8](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Int8) Filter(pred func(int8) bool) Int8 {
+	return Int8(Option[int8](opt).Filter(pred))
+}
+
+func (opt Int8) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Int8) getValue() int8 {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Int8) String() string {
+	return Option[int8](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Int8) MarshalJSON() ([]byte, error) {
+	return Option[int8](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Int8) UnmarshalJSON(data []byte) error {
+	return (*Option[int8])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *Int8) Scan(src interface{}) error {
+	return (*Option[int8])(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface.
+func (opt Int8) Value() (driver.Value, error) {
+	return Option[int8](opt).Value()
+}
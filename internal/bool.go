@@ -0,0 +1,17 @@
+package internal
+
+import "strings"
+
+// ParseBool parses the text forms SQL databases use for booleans: Postgres
+// 't' and 'f', 'true' and 'false', 'yes' and 'no', 'on' and 'off', '1' and
+// '0', in any case, and the single 0x01 or 0x00 byte of a MySQL BIT(1). ok is
+// false if s is none of them.
+func ParseBool(s string) (value bool, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "\x01", "t", "true", "y", "yes", "on", "1":
+		return true, true
+	case "\x00", "f", "false", "n", "no", "off", "0":
+		return false, true
+	}
+	return false, false
+}
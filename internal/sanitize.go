@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// SanitizePolicy selects what happens to characters that Postgres can't
+// store in text and jsonb columns.
+type SanitizePolicy int
+
+const (
+	// SanitizeInherit defers to the package wide default policy.
+	SanitizeInherit SanitizePolicy = iota
+	// SanitizeStrip removes the offending characters.
+	SanitizeStrip
+	// SanitizeReplace replaces the offending characters with U+FFFD.
+	SanitizeReplace
+	// SanitizeReject fails with an *InvalidCharError.
+	SanitizeReject
+	// SanitizeKeep leaves the value unchanged, for databases such as MySQL
+	// and SQLite that store the characters.
+	SanitizeKeep
+)
+
+// ResolvePolicy returns policy, or def if policy is SanitizeInherit.
+func ResolvePolicy(policy, def SanitizePolicy) SanitizePolicy {
+	if policy == SanitizeInherit {
+		return def
+	}
+	return policy
+}
+
+// InvalidCharError is returned when a value contains a character that can't
+// be stored and the SanitizeReject policy is in effect.
+type InvalidCharError struct {
+	Type   string // Go type of the value, e.g. "null.JSONB"
+	Offset int    // byte offset of the character in the value
+	Reason string // what is wrong with the character
+}
+
+func (e *InvalidCharError) Error() string {
+	return fmt.Sprintf("%s: %s at offset %d", e.Type, e.Reason, e.Offset)
+}
+
+var (
+	jsonNUL         = []byte(`\u0000`)
+	jsonReplacement = []byte(`\ufffd`)
+)
+
+// SanitizeJSON applies policy to the \u0000 escapes inside the strings of the
+// JSON document data. Escaped backslashes followed by "u0000" (the JSON
+// string "\\u0000") are not NUL characters and are left alone. data is
+// returned as is when it has no \u0000 escape, or when policy is
+// SanitizeKeep.
+func SanitizeJSON(data []byte, policy SanitizePolicy, typ string) ([]byte, error) {
+	if policy == SanitizeKeep || !bytes.Contains(data, jsonNUL) {
+		return data, nil
+	}
+
+	var out []byte
+	last := 0
+	inString := false
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; {
+		case c == '"':
+			inString = !inString
+		case c == '\\' && inString:
+			if !bytes.HasPrefix(data[i:], jsonNUL) {
+				// Skip the escaped character, which may be a quote or
+				// another backslash.
+				i++
+				continue
+			}
+
+			switch policy {
+			case SanitizeReject:
+				return nil, &InvalidCharError{Type: typ, Offset: i, Reason: `\u0000 escape in JSON string`}
+			case SanitizeReplace:
+				out = append(out, data[last:i]...)
+				out = append(out, jsonReplacement...)
+			default:
+				out = append(out, data[last:i]...)
+			}
+			i += len(jsonNUL) - 1
+			last = i + 1
+		}
+	}
+	if last == 0 {
+		return data, nil
+	}
+
+	return append(out, data[last:]...), nil
+}
+
+// SanitizeText applies policy to the NUL bytes and invalid UTF-8 sequences in
+// s, neither of which Postgres accepts in text columns. s is returned as is
+// when it has neither, or when policy is SanitizeKeep.
+func SanitizeText(s string, policy SanitizePolicy, typ string) (string, error) {
+	if policy == SanitizeKeep || utf8.ValidString(s) && strings.IndexByte(s, 0) < 0 {
+		return s, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != 0 && (r != utf8.RuneError || size > 1) {
+			b.WriteString(s[i : i+size])
+			i += size
+			continue
+		}
+
+		switch policy {
+		case SanitizeReject:
+			reason := "invalid UTF-8"
+			if r == 0 {
+				reason = "NUL byte"
+			}
+			return "", &InvalidCharError{Type: typ, Offset: i, Reason: reason}
+		case SanitizeReplace:
+			b.WriteRune(utf8.RuneError)
+		}
+		i += size
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSanitizeJSON(t *testing.T) {
+	tests := []struct {
+		in      string
+		policy  SanitizePolicy
+		want    string
+		wantErr int // offset of the rejected escape, or -1
+	}{
+		{`{"a":"b"}`, SanitizeStrip, `{"a":"b"}`, -1},
+		{`{"a":"x\u0000y"}`, SanitizeStrip, `{"a":"xy"}`, -1},
+		{`{"a":"x\u0000y"}`, SanitizeReplace, `{"a":"x\ufffdy"}`, -1},
+		{`{"a":"x\u0000y"}`, SanitizeReject, ``, 7},
+		{`{"a":"x\u0000y"}`, SanitizeKeep, `{"a":"x\u0000y"}`, -1},
+		{`["\u0000","\u0000"]`, SanitizeStrip, `["",""]`, -1},
+		{`{"\u0000":1}`, SanitizeStrip, `{"":1}`, -1},
+
+		// An escaped backslash followed by u0000 is the text \u0000.
+		{`{"a":"\\u0000"}`, SanitizeStrip, `{"a":"\\u0000"}`, -1},
+		{`{"a":"\\u0000"}`, SanitizeReject, `{"a":"\\u0000"}`, -1},
+		{`{"a":"\\\u0000"}`, SanitizeStrip, `{"a":"\\"}`, -1},
+		{`{"a":"\\\\u0000"}`, SanitizeStrip, `{"a":"\\\\u0000"}`, -1},
+
+		// Escaped quotes don't end the string.
+		{`{"a":"\"\u0000\""}`, SanitizeStrip, `{"a":"\"\""}`, -1},
+
+		// Other escapes are kept.
+		{`{"a":"\u0001\u00000"}`, SanitizeStrip, `{"a":"\u00010"}`, -1},
+	}
+	for _, tt := range tests {
+		got, err := SanitizeJSON([]byte(tt.in), tt.policy, "null.JSONB")
+		if tt.wantErr >= 0 {
+			var charErr *InvalidCharError
+			if !errors.As(err, &charErr) || charErr.Offset != tt.wantErr || charErr.Type != "null.JSONB" {
+				t.Errorf("SanitizeJSON(%s, %d) error = %v, want InvalidCharError at %d", tt.in, tt.policy, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || string(got) != tt.want {
+			t.Errorf("SanitizeJSON(%s, %d) = %s, %v, want %s", tt.in, tt.policy, got, err, tt.want)
+		}
+	}
+}
+
+func TestSanitizeText(t *testing.T) {
+	tests := []struct {
+		in         string
+		policy     SanitizePolicy
+		want       string
+		wantErr    int // offset of the rejected character, or -1
+		wantReason string
+	}{
+		{"abc", SanitizeStrip, "abc", -1, ""},
+		{"héllo", SanitizeReject, "héllo", -1, ""},
+		{"a\x00b", SanitizeStrip, "ab", -1, ""},
+		{"a\x00b", SanitizeReplace, "a\ufffdb", -1, ""},
+		{"a\x00b", SanitizeReject, "", 1, "NUL byte"},
+		{"a\x00b\xff", SanitizeKeep, "a\x00b\xff", -1, ""},
+		{"a\xffb", SanitizeStrip, "ab", -1, ""},
+		{"a\xffb", SanitizeReplace, "a\ufffdb", -1, ""},
+		{"ab\xff", SanitizeReject, "", 2, "invalid UTF-8"},
+		{"\xe2\x82", SanitizeStrip, "", -1, ""},
+		{"\xe2\x82", SanitizeReplace, "\ufffd\ufffd", -1, ""},
+		{"\ufffd", SanitizeReject, "\ufffd", -1, ""},
+	}
+	for _, tt := range tests {
+		got, err := SanitizeText(tt.in, tt.policy, "null.String")
+		if tt.wantErr >= 0 {
+			var charErr *InvalidCharError
+			if !errors.As(err, &charErr) || charErr.Offset != tt.wantErr || charErr.Reason != tt.wantReason {
+				t.Errorf("SanitizeText(%q, %d) error = %v, want %s at %d", tt.in, tt.policy, err, tt.wantReason, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("SanitizeText(%q, %d) = %q, %v, want %q", tt.in, tt.policy, got, err, tt.want)
+		}
+	}
+}
+
+func TestResolvePolicy(t *testing.T) {
+	if got := ResolvePolicy(SanitizeInherit, SanitizeStrip); got != SanitizeStrip {
+		t.Errorf("ResolvePolicy(SanitizeInherit, SanitizeStrip) = %d", got)
+	}
+	if got := ResolvePolicy(SanitizeKeep, SanitizeStrip); got != SanitizeKeep {
+		t.Errorf("ResolvePolicy(SanitizeKeep, SanitizeStrip) = %d", got)
+	}
+}
@@ -0,0 +1,206 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// JSON is a nullable json or jsonb column decoded into a Go value of type T.
+//
+//	var settings null.JSON[map[string]interface{}]
+//	_ = db.QueryRow(`select settings from users limit 1`).Scan(&settings)
+type JSON[T any] Option[T]
+
+func NewJSON[T any](value T, hasValue bool) JSON[T] {
+	opt := &JSON[T]{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeJSON returns a JSON containing value.
+func SomeJSON[T any](value T) JSON[T] {
+	return NewJSON(value, true)
+}
+
+// NoneJSON returns a JSON containing no value.
+func NoneJSON[T any]() JSON[T] {
+	return JSON[T]{}
+}
+
+// SetValue performs the conversion.
+func (opt *JSON[T]) SetValue(value T) {
+	(*Option[T])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *JSON[T]) Clear() {
+	(*Option[T])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *JSON[T]) Take() JSON[T] {
+	return JSON[T]((*Option[T])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *JSON[T]) Replace(value T) JSON[T] {
+	return JSON[T]((*Option[T])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *JSON[T]) GetOrInsert(value T) T {
+	return (*Option[T])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *JSON[T]) GetOrInsertWith(fn func() T) T {
+	return (*Option[T])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt JSON[T]) Unwrap() (T, bool) {
+	return Option[T](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt JSON[T]) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt JSON[T]) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt JSON[T]) UnwrapOr(def T) T {
+	return Option[T](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt JSON[T]) UnwrapOrElse(fn func() T) T {
+	return Option[T](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt JSON[T]) UnwrapOrDefault() T {
+	return Option[T](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt JSON[T]) UnwrapOrPanic() T {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap JSON")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt JSON[T]) Or(optb JSON[T]) JSON[T] {
+	return JSON[T](Option[T](opt).Or(Option[T](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt JSON[T]) OrElse(fn func() JSON[T]) JSON[T] {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt JSON[T]) And(optb JSON[T]) JSON[T] {
+	return JSON[T](Option[T](opt).And(Option[T](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt JSON[T]) Xor(optb JSON[T]) JSON[T] {
+	return JSON[T](Option[T](opt).Xor(Option[T](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt JSON[T]) Filter(pred func(T) bool) JSON[T] {
+	return JSON[T](Option[T](opt).Filter(pred))
+}
+
+func (opt JSON[T]) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt JSON[T]) getValue() T {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt JSON[T]) String() string {
+	return Option[T](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt JSON[T]) MarshalJSON() ([]byte, error) {
+	return Option[T](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *JSON[T]) UnmarshalJSON(data []byte) error {
+	return (*Option[T])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface. The column is decoded into T
+// with encoding/json.
+func (opt *JSON[T]) Scan(src interface{}) error {
+	var zero T
+	if src == nil {
+		opt.value, opt.hasValue = zero, false
+		return nil
+	}
+
+	var data []byte
+	err := internal.ConvertAssign(&data, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var value T
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.Wrapf(err, "null: decoding JSON into %s", typeOf[T]())
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. T is encoded with
+// encoding/json, and the result is sent the same way as a JSONB value.
+func (opt JSON[T]) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(opt.getValue())
+	if err != nil {
+		return nil, errors.Wrapf(err, "null: encoding %s as JSON", typeOf[T]())
+	}
+	return NewJSONB(data, true).Value()
+}
+
+// typeOf returns the type T, which unlike the dynamic type of a T value is
+// known even when T is an interface type and the value is nil.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
@@ -4,17 +4,18 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/json"
-	"fmt"
 
 	"github.com/pkg/errors"
 
 	"github.com/Gurpartap/null/internal"
 )
 
-type JSONB struct {
-	hasValue bool
-	value    []byte
-}
+type JSONB Option[[]byte]
+
+// JSONBSanitizePolicy is what JSONB and JSON Value do with \u0000 escapes,
+// which Postgres rejects in jsonb. SanitizeInherit defers to
+// DefaultSanitizePolicy.
+var JSONBSanitizePolicy = SanitizeInherit
 
 func NewJSONB(value []byte, hasValue bool) JSONB {
 	opt := &JSONB{}
@@ -24,41 +25,87 @@ func NewJSONB(value []byte, hasValue bool) JSONB {
 	return *opt
 }
 
+// SomeJSONB returns a JSONB containing value.
+func SomeJSONB(value []byte) JSONB {
+	return NewJSONB(value, true)
+}
+
+// NoneJSONB returns a JSONB containing no value.
+func NoneJSONB() JSONB {
+	return JSONB{}
+}
+
 // SetValue performs the conversion.
 func (opt *JSONB) SetValue(value []byte) {
 	opt.value = append(opt.value[0:0], value...)
 	opt.hasValue = true
 }
 
+// Clear sets the optional to None and releases the backing array.
+func (opt *JSONB) Clear() {
+	(*Option[[]byte])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *JSONB) Take() JSONB {
+	return JSONB((*Option[[]byte])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *JSONB) Replace(value []byte) JSONB {
+	old := opt.Take()
+	opt.SetValue(value)
+	return old
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *JSONB) GetOrInsert(value []byte) []byte {
+	if !opt.getHasValue() {
+		opt.SetValue(value)
+	}
+	return opt.getValue()
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *JSONB) GetOrInsertWith(fn func() []byte) []byte {
+	if !opt.getHasValue() {
+		opt.SetValue(fn())
+	}
+	return opt.getValue()
+}
+
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt JSONB) Unwrap() ([]byte, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[[]byte](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt JSONB) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt JSONB) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt JSONB) UnwrapOr(def []byte) []byte {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[[]byte](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt JSONB) UnwrapOrElse(fn func() []byte) []byte {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[[]byte](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt JSONB) UnwrapOrDefault() []byte {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return nil
+	return Option[[]byte](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -69,6 +116,37 @@ func (opt JSONB) UnwrapOrPanic() []byte {
 	panic("unable to unwrap JSONB")
 }
 
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt JSONB) Or(optb JSONB) JSONB {
+	return JSONB(Option[[]byte](opt).Or(Option[[]byte](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt JSONB) OrElse(fn func() JSONB) JSONB {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt JSONB) And(optb JSONB) JSONB {
+	return JSONB(Option[[]byte](opt).And(Option[[]byte](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt JSONB) Xor(optb JSONB) JSONB {
+	return JSONB(Option[[]byte](opt).Xor(Option[[]byte](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt JSONB) Filter(pred func([]byte) bool) JSONB {
+	return JSONB(Option[[]byte](opt).Filter(pred))
+}
+
 func (opt JSONB) getHasValue() bool {
 	return opt.hasValue
 }
@@ -79,52 +157,46 @@ func (opt JSONB) getValue() []byte {
 
 // String conforms to fmt Stringer interface.
 func (opt JSONB) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[[]byte](opt).String()
 }
 
-// MarshalJSON implements the json Marshaler interface.
+// MarshalJSON implements the json Marshaler interface. The document is
+// embedded as raw JSON.
 func (opt JSONB) MarshalJSON() ([]byte, error) {
 	if !opt.getHasValue() {
 		return []byte("null"), nil
 	}
-	return json.Marshal(opt.getValue())
+
+	if bytes.Equal(opt.getValue(), []byte{}) {
+		return []byte("{}"), nil
+	}
+
+	if !json.Valid(opt.getValue()) {
+		return nil, errors.New("null: JSONB contains invalid JSON")
+	}
+	return opt.getValue(), nil
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
+// UnmarshalJSON implements the json Unmarshaler interface. The raw JSON
+// document is stored as is.
 func (opt *JSONB) UnmarshalJSON(data []byte) error {
 	if data == nil || bytes.Equal(data, []byte("null")) {
 		opt.value, opt.hasValue = nil, false
 		return nil
 	}
 
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
+	if !json.Valid(data) {
 		opt.hasValue = false
-		return errors.WithStack(err)
+		return errors.New("null: invalid JSON for JSONB")
 	}
-	opt.hasValue = true
+	opt.SetValue(data)
 
 	return nil
 }
 
 // Scan implements the sql Scanner interface.
 func (opt *JSONB) Scan(src interface{}) error {
-	if src == nil {
-		opt.value, opt.hasValue = nil, false
-		return nil
-	}
-
-	var value []byte
-	err := internal.ConvertAssign(&value, src)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	opt.SetValue(value)
-
-	return nil
+	return (*Option[[]byte])(opt).Scan(src)
 }
 
 // Value implements the driver Valuer interface.
@@ -137,7 +209,7 @@ func (opt JSONB) Value() (driver.Value, error) {
 		return []byte("{}"), nil
 	}
 
-	// Remove NUL character byte(s)
+	// Sanitize NUL character escape(s)
 	//
 	// Postgres will reject jsonb with \u0000. For details, see
 	// https://www.postgresql.org/docs/9.4/static/release-9-4-1.html
@@ -148,5 +220,10 @@ func (opt JSONB) Value() (driver.Value, error) {
 	// represented in PostgreSQL's text type."
 	//
 	// https://www.compose.com/articles/faster-operations-with-the-jsonb-data-type-in-postgresql/
-	return bytes.Replace(opt.getValue(), []byte("\\u0000"), []byte{}, -1), nil
+	policy := internal.ResolvePolicy(JSONBSanitizePolicy, DefaultSanitizePolicy)
+	value, err := internal.SanitizeJSON(opt.getValue(), policy, "null.JSONB")
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
 }
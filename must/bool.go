@@ -0,0 +1,73 @@
+package must
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+type Bool bool
+
+// BoolNullPolicy is what Bool Scan does with NULL. NullInherit defers to
+// DefaultNullPolicy.
+var BoolNullPolicy = NullInherit
+
+// MarshalJSON implements the json Marshaler interface.
+func (v Bool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bool(v))
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. null is read as
+// false.
+func (v *Bool) UnmarshalJSON(data []byte) error {
+	var value bool
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Bool(value)
+	return nil
+}
+
+// Scan implements the sql Scanner interface. Like null.Bool, it reads the
+// text forms of booleans, such as Postgres 't' and 'f', and MySQL BIT(1)
+// bytes.
+func (v *Bool) Scan(src interface{}) error {
+	if src == nil {
+		*v = false
+		return scanNull(BoolNullPolicy, "must.Bool")
+	}
+
+	var s string
+	switch t := src.(type) {
+	case string:
+		s = t
+	case []byte:
+		s = string(t)
+	}
+	if s != "" {
+		value, ok := internal.ParseBool(s)
+		if !ok {
+			return errors.Errorf("must: cannot parse %q as Bool", s)
+		}
+		*v = Bool(value)
+		return nil
+	}
+
+	var value bool
+	err := internal.ConvertAssign(&value, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Bool(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (v Bool) Value() (driver.Value, error) {
+	return bool(v), nil
+}
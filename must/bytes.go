@@ -0,0 +1,62 @@
+package must
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+type Bytes []byte
+
+// BytesNullPolicy is what Bytes Scan does with NULL. NullInherit defers to
+// DefaultNullPolicy.
+var BytesNullPolicy = NullInherit
+
+// MarshalJSON implements the json Marshaler interface.
+func (v Bytes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte(`""`), nil
+	}
+	return json.Marshal([]byte(v))
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. null is read as
+// empty bytes.
+func (v *Bytes) UnmarshalJSON(data []byte) error {
+	var value []byte
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = append((*v)[0:0], value...)
+	return nil
+}
+
+// Scan implements the sql Scanner interface.
+func (v *Bytes) Scan(src interface{}) error {
+	if src == nil {
+		*v = (*v)[0:0]
+		return scanNull(BytesNullPolicy, "must.Bytes")
+	}
+
+	var value []byte
+	err := internal.ConvertAssign(&value, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = append((*v)[0:0], value...)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. Nil bytes are sent as empty
+// bytes rather than NULL.
+func (v Bytes) Value() (driver.Value, error) {
+	if v == nil {
+		return []byte{}, nil
+	}
+	return []byte(v), nil
+}
@@ -0,0 +1,55 @@
+package must
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+type Float64 float64
+
+// Float64NullPolicy is what Float64 Scan does with NULL. NullInherit defers to
+// DefaultNullPolicy.
+var Float64NullPolicy = NullInherit
+
+// MarshalJSON implements the json Marshaler interface.
+func (v Float64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float64(v))
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. null is read as
+// zero.
+func (v *Float64) UnmarshalJSON(data []byte) error {
+	var value float64
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Float64(value)
+	return nil
+}
+
+// Scan implements the sql Scanner interface.
+func (v *Float64) Scan(src interface{}) error {
+	if src == nil {
+		*v = 0
+		return scanNull(Float64NullPolicy, "must.Float64")
+	}
+
+	var value float64
+	err := internal.ConvertAssign(&value, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Float64(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (v Float64) Value() (driver.Value, error) {
+	return float64(v), nil
+}
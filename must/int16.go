@@ -0,0 +1,55 @@
+package must
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+type Int16 int16
+
+// Int16NullPolicy is what Int16 Scan does with NULL. NullInherit defers to
+// DefaultNullPolicy.
+var Int16NullPolicy = NullInherit
+
+// MarshalJSON implements the json Marshaler interface.
+func (v Int16) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int16(v))
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. null is read as
+// zero.
+func (v *Int16) UnmarshalJSON(data []byte) error {
+	var value int16
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Int16(value)
+	return nil
+}
+
+// Scan implements the sql Scanner interface.
+func (v *Int16) Scan(src interface{}) error {
+	if src == nil {
+		*v = 0
+		return scanNull(Int16NullPolicy, "must.Int16")
+	}
+
+	var value int16
+	err := internal.ConvertAssign(&value, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Int16(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (v Int16) Value() (driver.Value, error) {
+	return int64(v), nil
+}
@@ -0,0 +1,55 @@
+package must
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+type Int64 int64
+
+// Int64NullPolicy is what Int64 Scan does with NULL. NullInherit defers to
+// DefaultNullPolicy.
+var Int64NullPolicy = NullInherit
+
+// MarshalJSON implements the json Marshaler interface.
+func (v Int64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(v))
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. null is read as
+// zero.
+func (v *Int64) UnmarshalJSON(data []byte) error {
+	var value int64
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Int64(value)
+	return nil
+}
+
+// Scan implements the sql Scanner interface.
+func (v *Int64) Scan(src interface{}) error {
+	if src == nil {
+		*v = 0
+		return scanNull(Int64NullPolicy, "must.Int64")
+	}
+
+	var value int64
+	err := internal.ConvertAssign(&value, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Int64(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (v Int64) Value() (driver.Value, error) {
+	return int64(v), nil
+}
@@ -0,0 +1,71 @@
+package must
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// JSON is a json or jsonb column decoded into a Go value of type T. A NULL
+// column scans as the zero value of T.
+type JSON[T any] struct {
+	Data T
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (v JSON[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Data)
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (v *JSON[T]) UnmarshalJSON(data []byte) error {
+	err := json.Unmarshal(data, &v.Data)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// Scan implements the sql Scanner interface. The column is decoded into T
+// with encoding/json.
+func (v *JSON[T]) Scan(src interface{}) error {
+	var value T
+	if src == nil {
+		v.Data = value
+		return nil
+	}
+
+	var data []byte
+	err := internal.ConvertAssign(&data, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.Wrapf(err, "must: decoding JSON into %s", typeOf[T]())
+	}
+	v.Data = value
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. T is encoded with
+// encoding/json, and the result is sent the same way as a JSONB value.
+func (v JSON[T]) Value() (driver.Value, error) {
+	data, err := json.Marshal(v.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "must: encoding %s as JSON", typeOf[T]())
+	}
+	return JSONB(data).Value()
+}
+
+// typeOf returns the type T, which unlike the dynamic type of a T value is
+// known even when T is an interface type and the value is nil.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
@@ -12,29 +12,37 @@ import (
 
 type JSONB []byte
 
-// MarshalJSON implements the json Marshaler interface.
+// JSONBSanitizePolicy is what JSONB and JSON Value do with \u0000 escapes,
+// which Postgres rejects in jsonb. SanitizeInherit defers to
+// DefaultSanitizePolicy.
+var JSONBSanitizePolicy = SanitizeInherit
+
+// MarshalJSON implements the json Marshaler interface. The document is
+// embedded as raw JSON.
 func (v JSONB) MarshalJSON() ([]byte, error) {
 	if bytes.Equal(v, []byte{}) || bytes.Equal(v, []byte("null")) {
 		return []byte("{}"), nil
 	}
 
+	if !json.Valid(v) {
+		return nil, errors.New("must: JSONB contains invalid JSON")
+	}
 	return v, nil
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
+// UnmarshalJSON implements the json Unmarshaler interface. The raw JSON
+// document is stored as is.
 func (v *JSONB) UnmarshalJSON(data []byte) error {
 	if data == nil || bytes.Equal(data, []byte("null")) {
 		*v = []byte{}
 		return nil
 	}
 
-	var value []byte
-	err := json.Unmarshal(data, &value)
-	if err != nil {
-		return errors.WithStack(err)
+	if !json.Valid(data) {
+		return errors.New("must: invalid JSON for JSONB")
 	}
 
-	*v = append((*v)[0:0], value...)
+	*v = append((*v)[0:0], data...)
 
 	return nil
 }
@@ -57,12 +65,13 @@ func (v *JSONB) Scan(src interface{}) error {
 	return nil
 }
 
+// Value implements the driver Valuer interface.
 func (v JSONB) Value() (driver.Value, error) {
 	if bytes.Equal(v, []byte{}) || bytes.Equal(v, []byte("null")) {
 		return []byte("{}"), nil
 	}
 
-	// Remove NUL character byte(s)
+	// Sanitize NUL character escape(s)
 	//
 	// Postgres will reject jsonb with \u0000. For details, see
 	// https://www.postgresql.org/docs/9.4/static/release-9-4-1.html
@@ -73,5 +82,10 @@ func (v JSONB) Value() (driver.Value, error) {
 	// represented in PostgreSQL's text type."
 	//
 	// https://www.compose.com/articles/faster-operations-with-the-jsonb-data-type-in-postgresql/
-	return bytes.Replace(v, []byte("\\u0000"), []byte{}, -1), nil
+	policy := internal.ResolvePolicy(JSONBSanitizePolicy, DefaultSanitizePolicy)
+	value, err := internal.SanitizeJSON(v, policy, "must.JSONB")
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
 }
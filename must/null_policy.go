@@ -0,0 +1,42 @@
+package must
+
+import "fmt"
+
+// NullPolicy selects what Scan does when a NOT NULL column comes back NULL,
+// as it may through a LEFT JOIN.
+type NullPolicy int
+
+const (
+	// NullInherit defers to DefaultNullPolicy.
+	NullInherit NullPolicy = iota
+	// NullAsZero scans NULL as the zero value of the type.
+	NullAsZero
+	// NullAsError makes Scan fail with a *NullError.
+	NullAsError
+)
+
+// DefaultNullPolicy is the policy of every type whose own policy is
+// NullInherit. Like the per type policies, it is meant to be set once during
+// program initialization.
+var DefaultNullPolicy = NullAsZero
+
+// NullError is returned by Scan for a NULL column under the NullAsError
+// policy.
+type NullError struct {
+	Type string // Go type scanned into, e.g. "must.Int64"
+}
+
+func (e *NullError) Error() string {
+	return fmt.Sprintf("%s: cannot scan NULL", e.Type)
+}
+
+// scanNull returns the error, if any, for scanning NULL into typ.
+func scanNull(policy NullPolicy, typ string) error {
+	if policy == NullInherit {
+		policy = DefaultNullPolicy
+	}
+	if policy == NullAsError {
+		return &NullError{Type: typ}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package must
+
+import "github.com/Gurpartap/null/internal"
+
+// SanitizePolicy selects what Value does with characters that Postgres can't
+// store, such as the \u0000 escape in jsonb and NUL bytes and invalid UTF-8
+// in text.
+type SanitizePolicy = internal.SanitizePolicy
+
+const (
+	// SanitizeInherit defers to DefaultSanitizePolicy.
+	SanitizeInherit = internal.SanitizeInherit
+	// SanitizeStrip removes the offending characters.
+	SanitizeStrip = internal.SanitizeStrip
+	// SanitizeReplace replaces the offending characters with U+FFFD.
+	SanitizeReplace = internal.SanitizeReplace
+	// SanitizeReject makes Value fail with an *InvalidCharError.
+	SanitizeReject = internal.SanitizeReject
+	// SanitizeKeep leaves the value unchanged, for databases such as MySQL
+	// and SQLite that store the characters.
+	SanitizeKeep = internal.SanitizeKeep
+)
+
+// InvalidCharError is returned by Value under the SanitizeReject policy.
+type InvalidCharError = internal.InvalidCharError
+
+// DefaultSanitizePolicy is the policy of every type whose own policy is
+// SanitizeInherit, which by default is only JSONB. Like the per type
+// policies, it is meant to be set once during program initialization.
+var DefaultSanitizePolicy = SanitizeStrip
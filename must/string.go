@@ -0,0 +1,65 @@
+package must
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+type String string
+
+// StringSanitizePolicy is what String Value does with NUL bytes and invalid
+// UTF-8, which Postgres rejects in text columns. The default, SanitizeKeep,
+// sends strings unchanged; SanitizeInherit defers to DefaultSanitizePolicy.
+var StringSanitizePolicy = SanitizeKeep
+
+// StringNullPolicy is what String Scan does with NULL. NullInherit defers to
+// DefaultNullPolicy.
+var StringNullPolicy = NullInherit
+
+// MarshalJSON implements the json Marshaler interface.
+func (v String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. null is read as
+// the empty string.
+func (v *String) UnmarshalJSON(data []byte) error {
+	var value string
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = String(value)
+	return nil
+}
+
+// Scan implements the sql Scanner interface.
+func (v *String) Scan(src interface{}) error {
+	if src == nil {
+		*v = ""
+		return scanNull(StringNullPolicy, "must.String")
+	}
+
+	var value string
+	err := internal.ConvertAssign(&value, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = String(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (v String) Value() (driver.Value, error) {
+	policy := internal.ResolvePolicy(StringSanitizePolicy, DefaultSanitizePolicy)
+	value, err := internal.SanitizeText(string(v), policy, "must.String")
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
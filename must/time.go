@@ -0,0 +1,56 @@
+package must
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+type Time time.Time
+
+// TimeNullPolicy is what Time Scan does with NULL. NullInherit defers to
+// DefaultNullPolicy.
+var TimeNullPolicy = NullInherit
+
+// MarshalJSON implements the json Marshaler interface.
+func (v Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(v))
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. null is read as
+// the zero time.
+func (v *Time) UnmarshalJSON(data []byte) error {
+	var value time.Time
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Time(value)
+	return nil
+}
+
+// Scan implements the sql Scanner interface.
+func (v *Time) Scan(src interface{}) error {
+	if src == nil {
+		*v = Time{}
+		return scanNull(TimeNullPolicy, "must.Time")
+	}
+
+	var value time.Time
+	err := internal.ConvertAssign(&value, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	*v = Time(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (v Time) Value() (driver.Value, error) {
+	return time.Time(v), nil
+}
@@ -0,0 +1,300 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// Option is a nullable value of any type T. The concrete types in this
+// package (String, Int64, Bool, ...) are defined in terms of Option, and
+// convert to and from their Option counterpart with a plain conversion:
+//
+//	opt := null.Option[string](null.NewString("a", true))
+//	str := null.String(opt)
+type Option[T any] struct {
+	hasValue bool
+	value    T
+}
+
+// Optional is implemented by Option and every concrete type in this package,
+// which lets Map, AndThen and Zip accept any of them. Those functions take the
+// optional's own type as a type parameter constrained by Optional, so T is
+// inferred from it rather than spelled out.
+type Optional[T any] interface {
+	Unwrap() (T, bool)
+}
+
+// Pair holds the values of two optionals combined with Zip.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+func NewOption[T any](value T, hasValue bool) Option[T] {
+	opt := &Option[T]{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// Some returns an Option containing value.
+func Some[T any](value T) Option[T] {
+	return NewOption(value, true)
+}
+
+// None returns an Option containing no value.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// SetValue performs the conversion.
+func (opt *Option[T]) SetValue(value T) {
+	opt.value = value
+	opt.hasValue = true
+}
+
+// Clear sets the optional to None.
+func (opt *Option[T]) Clear() {
+	var zero T
+	opt.value, opt.hasValue = zero, false
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Option[T]) Take() Option[T] {
+	old := *opt
+	opt.Clear()
+	return old
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Option[T]) Replace(value T) Option[T] {
+	old := opt.Take()
+	opt.SetValue(value)
+	return old
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Option[T]) GetOrInsert(value T) T {
+	if !opt.getHasValue() {
+		opt.SetValue(value)
+	}
+	return opt.getValue()
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Option[T]) GetOrInsertWith(fn func() T) T {
+	if !opt.getHasValue() {
+		opt.SetValue(fn())
+	}
+	return opt.getValue()
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Option[T]) Unwrap() (T, bool) {
+	return opt.getValue(), opt.getHasValue()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Option[T]) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Option[T]) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Option[T]) UnwrapOr(def T) T {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	return def
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Option[T]) UnwrapOrElse(fn func() T) T {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	return fn()
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Option[T]) UnwrapOrDefault() T {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	var def T
+	return def
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Option[T]) UnwrapOrPanic() T {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic(fmt.Sprintf("unable to unwrap %T", opt))
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Option[T]) Or(optb Option[T]) Option[T] {
+	if opt.getHasValue() {
+		return opt
+	}
+	return optb
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Option[T]) OrElse(fn func() Option[T]) Option[T] {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Option[T]) And(optb Option[T]) Option[T] {
+	if !opt.getHasValue() {
+		return Option[T]{}
+	}
+	return optb
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Option[T]) Xor(optb Option[T]) Option[T] {
+	switch {
+	case opt.getHasValue() && !optb.getHasValue():
+		return opt
+	case !opt.getHasValue() && optb.getHasValue():
+		return optb
+	}
+	return Option[T]{}
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Option[T]) Filter(pred func(T) bool) Option[T] {
+	if opt.getHasValue() && pred(opt.getValue()) {
+		return opt
+	}
+	return Option[T]{}
+}
+
+// Map converts the contained value with fn, if there is one.
+//
+//	length := null.Map(user.Name, func(s string) int { return len(s) })
+func Map[T, U any, O Optional[T]](opt O, fn func(T) U) Option[U] {
+	if value, ok := opt.Unwrap(); ok {
+		return Some(fn(value))
+	}
+	return Option[U]{}
+}
+
+// AndThen returns None if the optional is None, otherwise calls fn with the
+// contained value and returns the result. It is sometimes called flat map.
+func AndThen[T, U any, O Optional[T]](opt O, fn func(T) Option[U]) Option[U] {
+	if value, ok := opt.Unwrap(); ok {
+		return fn(value)
+	}
+	return Option[U]{}
+}
+
+// Zip returns Some(Pair{a, b}) if both optionals contain a value, otherwise
+// returns None. A and B are the types of the optionals, through which T and U
+// are inferred:
+//
+//	both := null.Zip(user.Name, user.Age) // Option[Pair[string, int64]]
+func Zip[T, U any, A Optional[T], B Optional[U]](a A, b B) Option[Pair[T, U]] {
+	valueA, okA := a.Unwrap()
+	valueB, okB := b.Unwrap()
+	if okA && okB {
+		return Some(Pair[T, U]{First: valueA, Second: valueB})
+	}
+	return Option[Pair[T, U]]{}
+}
+
+func (opt Option[T]) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Option[T]) getValue() T {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Option[T]) String() string {
+	if value, ok := opt.Unwrap(); ok {
+		return fmt.Sprintf("Some(%v)", value)
+	}
+	return "null"
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Option[T]) MarshalJSON() ([]byte, error) {
+	if !opt.getHasValue() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(opt.getValue())
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Option[T]) UnmarshalJSON(data []byte) error {
+	var zero T
+	if bytes.Equal(data, []byte("null")) || data == nil {
+		opt.value, opt.hasValue = zero, false
+		return nil
+	}
+
+	err := json.Unmarshal(data, &opt.value)
+	if err != nil {
+		opt.hasValue = false
+		return errors.WithStack(err)
+	}
+	opt.hasValue = true
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *Option[T]) Scan(src interface{}) error {
+	var zero T
+	if src == nil {
+		opt.value, opt.hasValue = zero, false
+		return nil
+	}
+
+	var value T
+	err := internal.ConvertAssign(&value, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+//
+// The contained value is converted with the driver's default parameter
+// converter, so any T that database/sql can bind (including named integer
+// and float types, and driver.Valuer implementations) is supported.
+func (opt Option[T]) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(opt.getValue())
+}
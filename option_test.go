@@ -0,0 +1,91 @@
+package null
+
+import (
+	"strconv"
+	"testing"
+)
+
+// Every concrete type is an Optional of its value type.
+var (
+	_ Optional[string]    = String{}
+	_ Optional[int64]     = Int64{}
+	_ Optional[bool]      = Bool{}
+	_ Optional[Dec]       = Decimal{}
+	_ Optional[CivilDate] = Date{}
+	_ Optional[int]       = Option[int]{}
+)
+
+func TestZip(t *testing.T) {
+	// T and U are inferred from the concrete types.
+	var both Option[Pair[string, int64]] = Zip(SomeString("a"), SomeInt64(1))
+	if pair, ok := both.Unwrap(); !ok || pair.First != "a" || pair.Second != 1 {
+		t.Errorf("Zip(Some(a), Some(1)) = %v", both)
+	}
+
+	if got := Zip(SomeString("a"), NoneInt64()); got.IsSome() {
+		t.Errorf("Zip(Some(a), None) = %v", got)
+	}
+	if got := Zip(NoneString(), Some(1.5)); got.IsSome() {
+		t.Errorf("Zip(None, Some(1.5)) = %v", got)
+	}
+	if got := Zip[string, int64](SomeString("a"), SomeInt64(2)); got.IsNone() {
+		t.Errorf("Zip[string, int64](Some(a), Some(2)) = %v", got)
+	}
+}
+
+func TestMapAndThen(t *testing.T) {
+	length := Map(SomeString("abc"), func(s string) int { return len(s) })
+	if length != Some(3) {
+		t.Errorf("Map(Some(abc), len) = %v", length)
+	}
+	if got := Map(NoneString(), func(s string) int { return len(s) }); got.IsSome() {
+		t.Errorf("Map(None, len) = %v", got)
+	}
+
+	parse := func(s string) Option[int] {
+		n, err := strconv.Atoi(s)
+		return NewOption(n, err == nil)
+	}
+	tests := []struct {
+		in   String
+		want Option[int]
+	}{
+		{SomeString("42"), Some(42)},
+		{SomeString("x"), None[int]()},
+		{NoneString(), None[int]()},
+	}
+	for _, tt := range tests {
+		if got := AndThen(tt.in, parse); got != tt.want {
+			t.Errorf("AndThen(%v, parse) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	a, b, none := SomeString("a"), SomeString("b"), NoneString()
+	tests := []struct {
+		name      string
+		got, want String
+	}{
+		{"Some Or Some", a.Or(b), a},
+		{"None Or Some", none.Or(b), b},
+		{"None Or None", none.Or(none), none},
+		{"Some And Some", a.And(b), b},
+		{"None And Some", none.And(b), none},
+		{"Some And None", a.And(none), none},
+		{"Some Xor Some", a.Xor(b), none},
+		{"Some Xor None", a.Xor(none), a},
+		{"None Xor Some", none.Xor(b), b},
+		{"None Xor None", none.Xor(none), none},
+		{"Filter kept", a.Filter(func(s string) bool { return s == "a" }), a},
+		{"Filter dropped", a.Filter(func(s string) bool { return s == "b" }), none},
+		{"None Filter", none.Filter(func(string) bool { return true }), none},
+		{"Some OrElse", a.OrElse(func() String { return b }), a},
+		{"None OrElse", none.OrElse(func() String { return b }), b},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
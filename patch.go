@@ -0,0 +1,256 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Patch is a tri-state optional for partial updates. Unlike Option, it tells
+// a missing JSON key (absent) apart from an explicit null (Null) and from a
+// value (Some).
+//
+// The zero value is absent. encoding/json never calls UnmarshalJSON for a
+// missing key, so a Patch field stays absent unless its key was sent. Tag
+// fields with `json:",omitzero"` to leave absent fields out on marshal.
+type Patch[T any] struct {
+	present bool
+	opt     Option[T]
+}
+
+// PatchSome returns a present Patch containing value.
+func PatchSome[T any](value T) Patch[T] {
+	return Patch[T]{present: true, opt: Some(value)}
+}
+
+// PatchNull returns a present Patch containing an explicit null.
+func PatchNull[T any]() Patch[T] {
+	return Patch[T]{present: true}
+}
+
+// PatchFrom returns a present Patch containing opt.
+func PatchFrom[T any, O Optional[T]](opt O) Patch[T] {
+	value, ok := opt.Unwrap()
+	return Patch[T]{present: true, opt: NewOption(value, ok)}
+}
+
+// SetValue performs the conversion.
+func (p *Patch[T]) SetValue(value T) {
+	p.opt.SetValue(value)
+	p.present = true
+}
+
+// SetNull sets the patch to an explicit null.
+func (p *Patch[T]) SetNull() {
+	p.opt.Clear()
+	p.present = true
+}
+
+// Clear sets the patch back to absent.
+func (p *Patch[T]) Clear() {
+	p.opt.Clear()
+	p.present = false
+}
+
+// Unwrap moves the value out of the patch, if it is Some(value).
+func (p Patch[T]) Unwrap() (T, bool) {
+	return p.opt.Unwrap()
+}
+
+// Option returns the patch as an Option. Both absent and null patches
+// become None.
+func (p Patch[T]) Option() Option[T] {
+	return p.opt
+}
+
+// IsPresent returns true if the patch is either null or contains a value.
+func (p Patch[T]) IsPresent() bool {
+	return p.present
+}
+
+// IsAbsent returns true if the patch was not set.
+func (p Patch[T]) IsAbsent() bool {
+	return !p.present
+}
+
+// IsNull returns true if the patch was explicitly set to null.
+func (p Patch[T]) IsNull() bool {
+	return p.present && !p.opt.getHasValue()
+}
+
+// IsSome returns true if the patch contains a value.
+func (p Patch[T]) IsSome() bool {
+	return p.opt.getHasValue()
+}
+
+// IsZero reports whether the patch is absent. It is used by the omitzero
+// option of encoding/json.
+func (p Patch[T]) IsZero() bool {
+	return !p.present
+}
+
+// String conforms to fmt Stringer interface.
+func (p Patch[T]) String() string {
+	if !p.present {
+		return "absent"
+	}
+	return p.opt.String()
+}
+
+// MarshalJSON implements the json Marshaler interface. Absent patches marshal
+// as null, unless omitted with omitzero.
+func (p Patch[T]) MarshalJSON() ([]byte, error) {
+	return p.opt.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (p *Patch[T]) UnmarshalJSON(data []byte) error {
+	p.present = true
+	return p.opt.UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface. A scanned patch is always
+// present. Like Value, it goes through the nullable type of T, if any.
+func (p *Patch[T]) Scan(src interface{}) error {
+	p.present = true
+	if opt := nullTypeOf(&p.opt); opt != nil {
+		return opt.Scan(src)
+	}
+	return p.opt.Scan(src)
+}
+
+// Value implements the driver Valuer interface. Absent and null patches both
+// produce NULL; use SetClause to leave absent fields out of an UPDATE.
+//
+// When T has a nullable type in this package, such as String for string or
+// Time for time.Time, the value is sent the way that type sends it, e.g.
+// sanitized or normalized.
+func (p Patch[T]) Value() (driver.Value, error) {
+	if opt := nullTypeOf(&p.opt); opt != nil {
+		return opt.Value()
+	}
+	return p.opt.Value()
+}
+
+type nullType interface {
+	sql.Scanner
+	driver.Valuer
+}
+
+// nullTypeOf returns opt as the nullable type of this package whose Scan or
+// Value does more than Option's, or nil if T has none.
+func nullTypeOf[T any](opt *Option[T]) nullType {
+	switch opt := interface{}(opt).(type) {
+	case *Option[string]:
+		return (*String)(opt)
+	case *Option[bool]:
+		return (*Bool)(opt)
+	case *Option[time.Time]:
+		return (*Time)(opt)
+	case *Option[uint64]:
+		return (*Uint64)(opt)
+	case *Option[float32]:
+		return (*Float32)(opt)
+	case *Option[[16]byte]:
+		return (*UUID)(opt)
+	case *Option[*big.Int]:
+		return (*BigInt)(opt)
+	case *Option[[]int64]:
+		return (*Int64Slice)(opt)
+	}
+	return nil
+}
+
+type patchField interface {
+	driver.Valuer
+	IsPresent() bool
+}
+
+var patchFieldType = reflect.TypeOf((*patchField)(nil)).Elem()
+
+// SetClause builds the SET clause of an UPDATE statement from the present
+// Patch fields of src, which must be a struct or a pointer to one. Column
+// names are read from the `db` struct tag, falling back to the lower cased
+// field name, and fields tagged `db:"-"` are skipped. Column names are double
+// quoted, so reserved words such as "order" and "user" can be used, and
+// placeholders are numbered Postgres style, starting at $1.
+//
+//	set, args, err := null.SetClause(patch)
+//	if err != nil || set == "" {
+//		return err
+//	}
+//	args = append(args, id)
+//	query := "UPDATE users SET " + set + " WHERE id = $" + strconv.Itoa(len(args))
+//
+// Fields may also be *Patch, in which case nil is absent. The returned clause
+// is empty when no field is present.
+func SetClause(src interface{}) (string, []interface{}, error) {
+	rv := reflect.ValueOf(src)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "", nil, errors.New("null: SetClause of nil pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return "", nil, errors.Errorf("null: SetClause of non-struct type %s", rv.Type())
+	}
+
+	var columns []string
+	var args []interface{}
+	appendSetClause(rv, &columns, &args)
+
+	return strings.Join(columns, ", "), args, nil
+}
+
+func appendSetClause(rv reflect.Value, columns *[]string, args *[]interface{}) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+
+		fv := rv.Field(i)
+		if field.Anonymous && fv.Kind() == reflect.Struct {
+			if !fv.Type().Implements(patchFieldType) {
+				appendSetClause(fv, columns, args)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if fv.Kind() == reflect.Ptr && fv.IsNil() {
+			// A nil *Patch is absent.
+			continue
+		}
+
+		patch, ok := fv.Interface().(patchField)
+		if !ok {
+			continue
+		}
+		if !patch.IsPresent() {
+			continue
+		}
+
+		column := strings.Split(tag, ",")[0]
+		if column == "" {
+			column = strings.ToLower(field.Name)
+		}
+		*args = append(*args, patch)
+		*columns = append(*columns, quoteIdentifier(column)+" = $"+strconv.Itoa(len(*args)))
+	}
+}
+
+// quoteIdentifier returns name as a double quoted SQL identifier.
+func quoteIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
@@ -0,0 +1,116 @@
+package null
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSetClause(t *testing.T) {
+	type Embedded struct {
+		Note Patch[string] `db:"note"`
+	}
+	src := struct {
+		Embedded
+		Name   Patch[string] `db:"name"`
+		Order  Patch[int64]  `db:"order"`
+		User   Patch[bool]
+		Quoted Patch[string] `db:"a\"b"`
+		Absent Patch[string] `db:"absent"`
+		Skip   Patch[string] `db:"-"`
+		Plain  string
+	}{
+		Embedded: Embedded{Note: PatchSome("n")},
+		Name:     PatchSome("x"),
+		Order:    PatchNull[int64](),
+		User:     PatchSome(true),
+		Quoted:   PatchSome("q"),
+		Skip:     PatchSome("s"),
+		Plain:    "p",
+	}
+
+	set, args, err := SetClause(&src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `"note" = $1, "name" = $2, "order" = $3, "user" = $4, "a""b" = $5`
+	if set != want {
+		t.Errorf("SetClause set = %s, want %s", set, want)
+	}
+	if len(args) != 5 {
+		t.Errorf("SetClause args = %v, want 5 of them", args)
+	}
+
+	if _, _, err := SetClause(1); err == nil {
+		t.Error("SetClause(1) did not fail")
+	}
+	if set, args, err := SetClause(struct{ A Patch[int] }{}); set != "" || args != nil || err != nil {
+		t.Errorf("SetClause of absent fields = %q, %v, %v", set, args, err)
+	}
+
+	some := PatchSome("x")
+	pointers := struct {
+		A *Patch[string]
+		B *Patch[string]
+	}{B: &some}
+	if set, args, err := SetClause(pointers); set != `"b" = $1` || len(args) != 1 || err != nil {
+		t.Errorf("SetClause of *Patch fields = %q, %v, %v", set, args, err)
+	}
+}
+
+func TestPatchValueUsesNullTypes(t *testing.T) {
+	defer func(policy SanitizePolicy) { StringSanitizePolicy = policy }(StringSanitizePolicy)
+	StringSanitizePolicy = SanitizeStrip
+
+	tests := []struct {
+		name  string
+		patch driver.Valuer
+		want  driver.Value
+	}{
+		{"string", PatchSome("a\x00b"), "ab"},
+		{"uint64", PatchSome(uint64(1<<63 + 1)), "9223372036854775809"},
+		{"time", PatchSome(TimeInfinity), "infinity"},
+		{"uuid", PatchSome(MustParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")), "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"dec", PatchSome(MustParseDec("1.50")), "1.50"},
+		{"date", PatchSome(CivilDate{2024, 2, 29}), "2024-02-29"},
+		{"interval", PatchSome(Interval{Days: 1}), "1 day"},
+		{"null", PatchNull[string](), nil},
+		{"int", PatchSome(5), int64(5)},
+	}
+	for _, tt := range tests {
+		got, err := tt.patch.Value()
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Value() = %#v, %v, want %#v", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestPatchFrom(t *testing.T) {
+	// T is inferred from the concrete type.
+	p := PatchFrom(SomeString("a"))
+	if value, ok := p.Unwrap(); !ok || value != "a" || !p.IsPresent() {
+		t.Errorf("PatchFrom(Some(a)) = %v", p)
+	}
+	if p := PatchFrom(NoneInt64()); !p.IsNull() {
+		t.Errorf("PatchFrom(None) = %v", p)
+	}
+}
+
+func TestPatchScanUsesNullTypes(t *testing.T) {
+	var b Patch[bool]
+	if err := b.Scan("t"); err != nil || !b.IsPresent() || !b.opt.getValue() {
+		t.Errorf("Patch[bool] Scan(t) = %v, %v", b, err)
+	}
+
+	var at Patch[time.Time]
+	if err := at.Scan("2024-01-02 03:04:05"); err != nil || !at.IsSome() {
+		t.Errorf("Patch[time.Time] Scan = %v, %v", at, err)
+	}
+
+	var n Patch[*big.Int]
+	if err := n.Scan(nil); err != nil || !n.IsNull() {
+		t.Errorf("Patch[*big.Int] Scan(nil) = %v, %v", n, err)
+	}
+}
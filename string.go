@@ -1,20 +1,17 @@
 package null
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
-
-	"github.com/pkg/errors"
 
 	"github.com/Gurpartap/null/internal"
 )
 
-type String struct {
-	hasValue bool
-	value    string
-}
+type String Option[string]
+
+// StringSanitizePolicy is what String Value does with NUL bytes and invalid
+// UTF-8, which Postgres rejects in text columns. The default, SanitizeKeep,
+// sends strings unchanged; SanitizeInherit defers to DefaultSanitizePolicy.
+var StringSanitizePolicy = SanitizeKeep
 
 func NewString(value string, hasValue bool) String {
 	opt := &String{}
@@ -24,41 +21,78 @@ func NewString(value string, hasValue bool) String {
 	return *opt
 }
 
+// SomeString returns a String containing value.
+func SomeString(value string) String {
+	return NewString(value, true)
+}
+
+// NoneString returns a String containing no value.
+func NoneString() String {
+	return String{}
+}
+
 // SetValue performs the conversion.
 func (opt *String) SetValue(value string) {
-	opt.value = value
-	opt.hasValue = true
+	(*Option[string])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *String) Clear() {
+	(*Option[string])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *String) Take() String {
+	return String((*Option[string])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *String) Replace(value string) String {
+	return String((*Option[string])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *String) GetOrInsert(value string) string {
+	return (*Option[string])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *String) GetOrInsertWith(fn func() string) string {
+	return (*Option[string])(opt).GetOrInsertWith(fn)
 }
 
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt String) Unwrap() (string, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[string](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt String) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt String) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt String) UnwrapOr(def string) string {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[string](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt String) UnwrapOrElse(fn func() string) string {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[string](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt String) UnwrapOrDefault() string {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return ""
+	return Option[string](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -71,10 +105,33 @@ func (opt String) UnwrapOrPanic() string {
 
 // Or returns the optional if it contains a value, otherwise returns optb.
 func (opt String) Or(optb String) String {
+	return String(Option[string](opt).Or(Option[string](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt String) OrElse(fn func() String) String {
 	if opt.getHasValue() {
 		return opt
 	}
-	return optb
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt String) And(optb String) String {
+	return String(Option[string](opt).And(Option[string](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt String) Xor(optb String) String {
+	return String(Option[string](opt).Xor(Option[string](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt String) Filter(pred func(string) bool) String {
+	return String(Option[string](opt).Filter(pred))
 }
 
 func (opt String) getHasValue() bool {
@@ -87,52 +144,22 @@ func (opt String) getValue() string {
 
 // String conforms to fmt Stringer interface.
 func (opt String) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[string](opt).String()
 }
 
 // MarshalJSON implements the json Marshaler interface.
 func (opt String) MarshalJSON() ([]byte, error) {
-	if !opt.getHasValue() {
-		return []byte("null"), nil
-	}
-	return json.Marshal(opt.getValue())
+	return Option[string](opt).MarshalJSON()
 }
 
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (opt *String) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) || data == nil {
-		opt.value, opt.hasValue = "", false
-		return nil
-	}
-
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
-		opt.hasValue = false
-		return errors.WithStack(err)
-	}
-	opt.hasValue = true
-
-	return nil
+	return (*Option[string])(opt).UnmarshalJSON(data)
 }
 
 // Scan implements the sql Scanner interface.
 func (opt *String) Scan(src interface{}) error {
-	if src == nil {
-		opt.value, opt.hasValue = "", false
-		return nil
-	}
-
-	var value string
-	err := internal.ConvertAssign(&value, src)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	opt.SetValue(value)
-
-	return nil
+	return (*Option[string])(opt).Scan(src)
 }
 
 // Value implements the driver Valuer interface.
@@ -140,5 +167,11 @@ func (opt String) Value() (driver.Value, error) {
 	if !opt.getHasValue() {
 		return nil, nil
 	}
-	return string(opt.getValue()), nil
+
+	policy := internal.ResolvePolicy(StringSanitizePolicy, DefaultSanitizePolicy)
+	value, err := internal.SanitizeText(opt.getValue(), policy, "null.String")
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
 }
@@ -1,22 +1,113 @@
 package null
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
+)
+
+type Time Option[time.Time]
+
+// TimeInfinity and TimeNegInfinity stand for the Postgres timestamps
+// 'infinity' and '-infinity'. They are the latest and earliest instants
+// time.Time can hold, so they compare after and before every other time.
+// Compare them with Equal, or with the IsInfinite and IsNegInfinite methods.
+var (
+	TimeInfinity    = time.Unix(1<<63-1-unixToInternal, 999999999).UTC()
+	TimeNegInfinity = time.Unix(-1<<63, 0).UTC()
+)
+
+// unixToInternal is the number of seconds from the zero time.Time to the
+// Unix epoch.
+const unixToInternal = 62135596800
 
-	"github.com/Gurpartap/null/internal"
+const (
+	textInfinity    = "infinity"
+	textNegInfinity = "-infinity"
 )
 
-type Time struct {
-	hasValue bool
-	value    time.Time
+// infinityText returns the Postgres text of t if it is one of the infinity
+// sentinels.
+func infinityText(t time.Time) (string, bool) {
+	switch {
+	case t.Equal(TimeInfinity):
+		return textInfinity, true
+	case t.Equal(TimeNegInfinity):
+		return textNegInfinity, true
+	}
+	return "", false
+}
+
+// parseInfinity returns the sentinel for the Postgres text s, which may be
+// 'infinity', '+infinity' or '-infinity'.
+func parseInfinity(s string) (time.Time, bool) {
+	switch s {
+	case textInfinity, "+" + textInfinity:
+		return TimeInfinity, true
+	case textNegInfinity:
+		return TimeNegInfinity, true
+	}
+	return time.Time{}, false
+}
+
+// TimeScanLayouts are the layouts Time Scan tries in order for string and
+// []byte sources, such as SQLite TEXT timestamps and MySQL DATETIME without
+// parseTime=true. Times without a zone are read as UTC.
+var TimeScanLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z07",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// TimeScanEpochUnit is the unit of integer sources, such as SQLite INTEGER
+// timestamps, which Time Scan reads as Unix time: time.Second,
+// time.Millisecond, and so on. 0 makes Scan reject integer sources.
+var TimeScanEpochUnit = time.Second
+
+// TimeNormalization describes how Time Scan and Value normalize times, so a
+// time compares equal to itself after a round trip through the database.
+// Normalized times never carry a monotonic clock reading.
+type TimeNormalization struct {
+	// Location converts times to it, e.g. time.UTC. nil keeps the location
+	// the time or the driver has.
+	Location *time.Location
+	// Precision truncates times to a multiple of it: time.Microsecond for
+	// Postgres, time.Millisecond for MySQL DATETIME(3). 0 keeps nanoseconds.
+	Precision time.Duration
+	// Round rounds to Precision instead of truncating, like Postgres does
+	// with the times it stores.
+	Round bool
+}
+
+// Normalize returns t normalized. TimeInfinity and TimeNegInfinity are
+// returned as is.
+func (n TimeNormalization) Normalize(t time.Time) time.Time {
+	if _, ok := infinityText(t); ok {
+		return t
+	}
+	t = t.Round(0)
+	if n.Precision > 0 {
+		if n.Round {
+			t = t.Round(n.Precision)
+		} else {
+			t = t.Truncate(n.Precision)
+		}
+	}
+	if n.Location != nil {
+		t = t.In(n.Location)
+	}
+	return t
 }
 
+// DefaultTimeNormalization is applied by Time Scan and Value. Like the other
+// package settings, it is meant to be set once during program
+// initialization.
+var DefaultTimeNormalization TimeNormalization
+
 func NewTime(value time.Time, hasValue bool) Time {
 	opt := &Time{}
 	if hasValue {
@@ -25,41 +116,78 @@ func NewTime(value time.Time, hasValue bool) Time {
 	return *opt
 }
 
+// SomeTime returns a Time containing value.
+func SomeTime(value time.Time) Time {
+	return NewTime(value, true)
+}
+
+// NoneTime returns a Time containing no value.
+func NoneTime() Time {
+	return Time{}
+}
+
 // SetValue performs the conversion.
 func (opt *Time) SetValue(value time.Time) {
-	opt.value = value
-	opt.hasValue = true
+	(*Option[time.Time])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Time) Clear() {
+	(*Option[time.Time])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Time) Take() Time {
+	return Time((*Option[time.Time])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Time) Replace(value time.Time) Time {
+	return Time((*Option[time.Time])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Time) GetOrInsert(value time.Time) time.Time {
+	return (*Option[time.Time])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Time) GetOrInsertWith(fn func() time.Time) time.Time {
+	return (*Option[time.Time])(opt).GetOrInsertWith(fn)
 }
 
 // Unwrap moves the value out of the optional, if it is Some(value).
 // This function returns multiple values, and if that's undesirable,
 // consider using Some and None functions.
 func (opt Time) Unwrap() (time.Time, bool) {
-	return opt.getValue(), opt.getHasValue()
+	return Option[time.Time](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Time) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Time) IsNone() bool {
+	return !opt.getHasValue()
 }
 
 // UnwrapOr returns the contained value or a default.
 func (opt Time) UnwrapOr(def time.Time) time.Time {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return def
+	return Option[time.Time](opt).UnwrapOr(def)
 }
 
 // UnwrapOrElse returns the contained value or computes it from a closure.
 func (opt Time) UnwrapOrElse(fn func() time.Time) time.Time {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return fn()
+	return Option[time.Time](opt).UnwrapOrElse(fn)
 }
 
 // UnwrapOrDefault returns the contained value or the default.
 func (opt Time) UnwrapOrDefault() time.Time {
-	if opt.getHasValue() {
-		return opt.getValue()
-	}
-	return time.Time{}
+	return Option[time.Time](opt).UnwrapOrDefault()
 }
 
 // UnwrapOrPanic returns the contained value or panics.
@@ -70,6 +198,47 @@ func (opt Time) UnwrapOrPanic() time.Time {
 	panic("unable to unwrap Time")
 }
 
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Time) Or(optb Time) Time {
+	return Time(Option[time.Time](opt).Or(Option[time.Time](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Time) OrElse(fn func() Time) Time {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Time) And(optb Time) Time {
+	return Time(Option[time.Time](opt).And(Option[time.Time](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Time) Xor(optb Time) Time {
+	return Time(Option[time.Time](opt).Xor(Option[time.Time](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Time) Filter(pred func(time.Time) bool) Time {
+	return Time(Option[time.Time](opt).Filter(pred))
+}
+
+// IsInfinite returns true if the optional contains TimeInfinity.
+func (opt Time) IsInfinite() bool {
+	return opt.getHasValue() && opt.getValue().Equal(TimeInfinity)
+}
+
+// IsNegInfinite returns true if the optional contains TimeNegInfinity.
+func (opt Time) IsNegInfinite() bool {
+	return opt.getHasValue() && opt.getValue().Equal(TimeNegInfinity)
+}
+
 func (opt Time) getHasValue() bool {
 	return opt.hasValue
 }
@@ -80,58 +249,87 @@ func (opt Time) getValue() time.Time {
 
 // String conforms to fmt Stringer interface.
 func (opt Time) String() string {
-	if value, ok := opt.Unwrap(); ok {
-		return fmt.Sprintf("Some(%v)", value)
-	}
-	return "null"
+	return Option[time.Time](opt).String()
 }
 
-// MarshalJSON implements the json Marshaler interface.
+// MarshalJSON implements the json Marshaler interface. The time is written
+// in the time.RFC3339Nano layout; use TimeAs for other formats. TimeInfinity
+// and TimeNegInfinity are written as "infinity" and "-infinity".
 func (opt Time) MarshalJSON() ([]byte, error) {
-	if !opt.getHasValue() {
-		return []byte("null"), nil
-	}
-	return json.Marshal(opt.getValue())
+	return marshalTimeJSON(Option[time.Time](opt), RFC3339{})
 }
 
-// UnmarshalJSON implements the json Unmarshaler interface.
+// UnmarshalJSON implements the json Unmarshaler interface. Strings in any of
+// TimeJSONLayouts are read.
 func (opt *Time) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, []byte("null")) || data == nil {
-		opt.value, opt.hasValue = time.Time{}, false
-		return nil
-	}
+	return unmarshalTimeJSON((*Option[time.Time])(opt), data, RFC3339{})
+}
 
-	err := json.Unmarshal(data, &opt.value)
-	if err != nil {
-		opt.hasValue = false
-		return errors.WithStack(err)
+// Scan implements the sql Scanner interface. Besides time.Time, it reads text
+// in any of TimeScanLayouts, and integers as Unix time in TimeScanEpochUnit.
+// The text 'infinity' and '-infinity' is read as TimeInfinity and
+// TimeNegInfinity. Scanned times are normalized with DefaultTimeNormalization.
+func (opt *Time) Scan(src interface{}) error {
+	value, err := scanTime(src)
+	if err != nil || src == nil {
+		opt.value, opt.hasValue = time.Time{}, false
+		return err
 	}
-	opt.hasValue = true
+	opt.SetValue(DefaultTimeNormalization.Normalize(value))
 
 	return nil
 }
 
-// Scan implements the sql Scanner interface.
-func (opt *Time) Scan(src interface{}) error {
-	if src == nil {
-		opt.value, opt.hasValue = time.Time{}, false
-		return nil
+// scanTime converts src, which is not nil, into a time.Time.
+func scanTime(src interface{}) (time.Time, error) {
+	var s string
+	switch v := src.(type) {
+	case int64:
+		return scanEpoch(v)
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		var value Option[time.Time]
+		err := value.Scan(src)
+		return value.getValue(), err
 	}
 
-	var value time.Time
-	err := internal.ConvertAssign(&value, src)
-	if err != nil {
-		return errors.WithStack(err)
+	if value, ok := parseInfinity(s); ok {
+		return value, nil
 	}
-	opt.SetValue(value)
+	for _, layout := range TimeScanLayouts {
+		value, err := time.Parse(layout, s)
+		if err == nil {
+			return value, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("null: cannot parse %q as Time", s)
+}
 
-	return nil
+// scanEpoch returns the Unix time v in TimeScanEpochUnit.
+func scanEpoch(v int64) (time.Time, error) {
+	unit := int64(TimeScanEpochUnit)
+	switch {
+	case unit <= 0:
+		return time.Time{}, errors.Errorf("null: cannot scan integer %d as Time", v)
+	case unit >= int64(time.Second):
+		return time.Unix(v*(unit/int64(time.Second)), 0).UTC(), nil
+	}
+	perSecond := int64(time.Second) / unit
+	return time.Unix(v/perSecond, v%perSecond*unit).UTC(), nil
 }
 
-// Value implements the driver Valuer interface.
+// Value implements the driver Valuer interface. The time is normalized with
+// DefaultTimeNormalization. TimeInfinity and TimeNegInfinity are sent as the
+// text 'infinity' and '-infinity'.
 func (opt Time) Value() (driver.Value, error) {
 	if !opt.getHasValue() {
 		return nil, nil
 	}
-	return time.Time(opt.getValue()), nil
+	if text, ok := infinityText(opt.getValue()); ok {
+		return text, nil
+	}
+	return DefaultTimeNormalization.Normalize(opt.getValue()), nil
 }
@@ -0,0 +1,341 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TimeCodec reads and writes the JSON form of a time. Codecs are used through
+// their zero value, as the type argument of TimeAs.
+//
+// Custom layouts are codecs built on EncodeTimeLayout and DecodeTimeLayouts:
+//
+//	type PartnerTime struct{}
+//
+//	func (PartnerTime) EncodeTime(t time.Time) ([]byte, error) {
+//		return null.EncodeTimeLayout(t, "2006-01-02 15:04:05")
+//	}
+//
+//	func (PartnerTime) DecodeTime(data []byte) (time.Time, error) {
+//		return null.DecodeTimeLayouts(data, "2006-01-02 15:04:05", time.RFC3339)
+//	}
+type TimeCodec interface {
+	EncodeTime(t time.Time) ([]byte, error)
+	DecodeTime(data []byte) (time.Time, error)
+}
+
+// TimeJSONLayouts are the layouts the RFC3339 codec, and so Time, tries in
+// order when decoding JSON strings. Append to it to accept other layouts as a
+// fallback; encoding always uses time.RFC3339Nano.
+var TimeJSONLayouts = []string{time.RFC3339Nano}
+
+// RFC3339 is the TimeCodec of Time. It writes strings in the
+// time.RFC3339Nano layout, like time.Time does, and reads strings in any of
+// TimeJSONLayouts.
+type RFC3339 struct{}
+
+// EncodeTime implements TimeCodec.
+func (RFC3339) EncodeTime(t time.Time) ([]byte, error) {
+	return EncodeTimeLayout(t, time.RFC3339Nano)
+}
+
+// DecodeTime implements TimeCodec.
+func (RFC3339) DecodeTime(data []byte) (time.Time, error) {
+	return DecodeTimeLayouts(data, TimeJSONLayouts...)
+}
+
+// UnixSeconds is a TimeCodec for Unix time in seconds, as a JSON number. It
+// writes whole seconds, dropping any fraction, and reads fractions of a second
+// down to nanoseconds.
+type UnixSeconds struct{}
+
+// EncodeTime implements TimeCodec.
+func (UnixSeconds) EncodeTime(t time.Time) ([]byte, error) {
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+// DecodeTime implements TimeCodec.
+func (UnixSeconds) DecodeTime(data []byte) (time.Time, error) {
+	d, err := ParseDec(string(data))
+	if err != nil {
+		return time.Time{}, errors.Errorf("null: invalid Unix seconds %s", data)
+	}
+	seconds, ok := d.Truncate(0).Int64()
+	if !ok {
+		return time.Time{}, errors.Errorf("null: Unix seconds %s out of range", data)
+	}
+	nanoseconds, _ := d.Sub(DecFromInt64(seconds)).Mul(DecFromInt64(int64(time.Second))).Int64()
+	return time.Unix(seconds, nanoseconds).UTC(), nil
+}
+
+// UnixMillis is a TimeCodec for Unix time in milliseconds, as a JSON integer.
+type UnixMillis struct{}
+
+// EncodeTime implements TimeCodec.
+func (UnixMillis) EncodeTime(t time.Time) ([]byte, error) {
+	return []byte(strconv.FormatInt(t.UnixMilli(), 10)), nil
+}
+
+// DecodeTime implements TimeCodec.
+func (UnixMillis) DecodeTime(data []byte) (time.Time, error) {
+	ms, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Errorf("null: invalid Unix milliseconds %s", data)
+	}
+	return time.UnixMilli(ms).UTC(), nil
+}
+
+// UnixNanos is a TimeCodec for Unix time in nanoseconds, as a JSON integer.
+// It covers the years 1678 to 2262.
+type UnixNanos struct{}
+
+// EncodeTime implements TimeCodec.
+func (UnixNanos) EncodeTime(t time.Time) ([]byte, error) {
+	return []byte(strconv.FormatInt(t.UnixNano(), 10)), nil
+}
+
+// DecodeTime implements TimeCodec.
+func (UnixNanos) DecodeTime(data []byte) (time.Time, error) {
+	ns, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Errorf("null: invalid Unix nanoseconds %s", data)
+	}
+	return time.Unix(0, ns).UTC(), nil
+}
+
+// EncodeTimeLayout writes t formatted with layout as a JSON string.
+func EncodeTimeLayout(t time.Time, layout string) ([]byte, error) {
+	return json.Marshal(t.Format(layout))
+}
+
+// DecodeTimeLayouts reads a JSON string with the first of layouts that
+// parses it. Times without a zone are read as UTC.
+func DecodeTimeLayouts(data []byte, layouts ...string) (time.Time, error) {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("null: cannot parse time %q", s)
+}
+
+// marshalTimeJSON writes opt with codec. The infinity sentinels are written
+// as "infinity" and "-infinity" whatever the codec.
+func marshalTimeJSON(opt Option[time.Time], codec TimeCodec) ([]byte, error) {
+	if !opt.getHasValue() {
+		return []byte("null"), nil
+	}
+	if text, ok := infinityText(opt.getValue()); ok {
+		return json.Marshal(text)
+	}
+	return codec.EncodeTime(opt.getValue())
+}
+
+// unmarshalTimeJSON reads data into opt with codec.
+func unmarshalTimeJSON(opt *Option[time.Time], data []byte, codec TimeCodec) error {
+	if bytes.Equal(data, []byte("null")) || data == nil {
+		opt.value, opt.hasValue = time.Time{}, false
+		return nil
+	}
+
+	var text string
+	if json.Unmarshal(data, &text) == nil {
+		if value, ok := parseInfinity(text); ok {
+			opt.SetValue(value)
+			return nil
+		}
+	}
+
+	value, err := codec.DecodeTime(data)
+	if err != nil {
+		opt.hasValue = false
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// TimeAs is a nullable time.Time whose JSON form is chosen per field by the
+// codec F, e.g. TimeAs[UnixMillis] reads and writes Unix milliseconds. It
+// scans and is sent to the database like Time, and converts to and from Time
+// with a plain conversion.
+type TimeAs[F TimeCodec] Option[time.Time]
+
+func NewTimeAs[F TimeCodec](value time.Time, hasValue bool) TimeAs[F] {
+	opt := &TimeAs[F]{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeTimeAs returns a TimeAs containing value.
+func SomeTimeAs[F TimeCodec](value time.Time) TimeAs[F] {
+	return NewTimeAs[F](value, true)
+}
+
+// NoneTimeAs returns a TimeAs containing no value.
+func NoneTimeAs[F TimeCodec]() TimeAs[F] {
+	return TimeAs[F]{}
+}
+
+// SetValue performs the conversion.
+func (opt *TimeAs[F]) SetValue(value time.Time) {
+	(*Option[time.Time])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *TimeAs[F]) Clear() {
+	(*Option[time.Time])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *TimeAs[F]) Take() TimeAs[F] {
+	return TimeAs[F]((*Option[time.Time])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *TimeAs[F]) Replace(value time.Time) TimeAs[F] {
+	return TimeAs[F]((*Option[time.Time])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *TimeAs[F]) GetOrInsert(value time.Time) time.Time {
+	return (*Option[time.Time])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *TimeAs[F]) GetOrInsertWith(fn func() time.Time) time.Time {
+	return (*Option[time.Time])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt TimeAs[F]) Unwrap() (time.Time, bool) {
+	return Option[time.Time](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt TimeAs[F]) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt TimeAs[F]) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt TimeAs[F]) UnwrapOr(def time.Time) time.Time {
+	return Option[time.Time](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt TimeAs[F]) UnwrapOrElse(fn func() time.Time) time.Time {
+	return Option[time.Time](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt TimeAs[F]) UnwrapOrDefault() time.Time {
+	return Option[time.Time](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt TimeAs[F]) UnwrapOrPanic() time.Time {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap TimeAs")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt TimeAs[F]) Or(optb TimeAs[F]) TimeAs[F] {
+	return TimeAs[F](Option[time.Time](opt).Or(Option[time.Time](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt TimeAs[F]) OrElse(fn func() TimeAs[F]) TimeAs[F] {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt TimeAs[F]) And(optb TimeAs[F]) TimeAs[F] {
+	return TimeAs[F](Option[time.Time](opt).And(Option[time.Time](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt TimeAs[F]) Xor(optb TimeAs[F]) TimeAs[F] {
+	return TimeAs[F](Option[time.Time](opt).Xor(Option[time.Time](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt TimeAs[F]) Filter(pred func(time.Time) bool) TimeAs[F] {
+	return TimeAs[F](Option[time.Time](opt).Filter(pred))
+}
+
+// IsInfinite returns true if the optional contains TimeInfinity.
+func (opt TimeAs[F]) IsInfinite() bool {
+	return Time(opt).IsInfinite()
+}
+
+// IsNegInfinite returns true if the optional contains TimeNegInfinity.
+func (opt TimeAs[F]) IsNegInfinite() bool {
+	return Time(opt).IsNegInfinite()
+}
+
+func (opt TimeAs[F]) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt TimeAs[F]) getValue() time.Time {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt TimeAs[F]) String() string {
+	return Option[time.Time](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt TimeAs[F]) MarshalJSON() ([]byte, error) {
+	var codec F
+	return marshalTimeJSON(Option[time.Time](opt), codec)
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *TimeAs[F]) UnmarshalJSON(data []byte) error {
+	var codec F
+	return unmarshalTimeJSON((*Option[time.Time])(opt), data, codec)
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *TimeAs[F]) Scan(src interface{}) error {
+	return (*Time)(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface.
+func (opt TimeAs[F]) Value() (driver.Value, error) {
+	return Time(opt).Value()
+}
@@ -0,0 +1,456 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// TimeOfDay is a nullable time of day, for time and timetz columns.
+type TimeOfDay Option[CivilTime]
+
+func NewTimeOfDay(value CivilTime, hasValue bool) TimeOfDay {
+	opt := &TimeOfDay{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeTimeOfDay returns a TimeOfDay containing value.
+func SomeTimeOfDay(value CivilTime) TimeOfDay {
+	return NewTimeOfDay(value, true)
+}
+
+// NoneTimeOfDay returns a TimeOfDay containing no value.
+func NoneTimeOfDay() TimeOfDay {
+	return TimeOfDay{}
+}
+
+// SetValue performs the conversion.
+func (opt *TimeOfDay) SetValue(value CivilTime) {
+	(*Option[CivilTime])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *TimeOfDay) Clear() {
+	(*Option[CivilTime])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *TimeOfDay) Take() TimeOfDay {
+	return TimeOfDay((*Option[CivilTime])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *TimeOfDay) Replace(value CivilTime) TimeOfDay {
+	return TimeOfDay((*Option[CivilTime])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *TimeOfDay) GetOrInsert(value CivilTime) CivilTime {
+	return (*Option[CivilTime])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *TimeOfDay) GetOrInsertWith(fn func() CivilTime) CivilTime {
+	return (*Option[CivilTime])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt TimeOfDay) Unwrap() (CivilTime, bool) {
+	return Option[CivilTime](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt TimeOfDay) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt TimeOfDay) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt TimeOfDay) UnwrapOr(def CivilTime) CivilTime {
+	return Option[CivilTime](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt TimeOfDay) UnwrapOrElse(fn func() CivilTime) CivilTime {
+	return Option[CivilTime](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt TimeOfDay) UnwrapOrDefault() CivilTime {
+	return Option[CivilTime](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt TimeOfDay) UnwrapOrPanic() CivilTime {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap TimeOfDay")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt TimeOfDay) Or(optb TimeOfDay) TimeOfDay {
+	return TimeOfDay(Option[CivilTime](opt).Or(Option[CivilTime](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt TimeOfDay) OrElse(fn func() TimeOfDay) TimeOfDay {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt TimeOfDay) And(optb TimeOfDay) TimeOfDay {
+	return TimeOfDay(Option[CivilTime](opt).And(Option[CivilTime](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt TimeOfDay) Xor(optb TimeOfDay) TimeOfDay {
+	return TimeOfDay(Option[CivilTime](opt).Xor(Option[CivilTime](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt TimeOfDay) Filter(pred func(CivilTime) bool) TimeOfDay {
+	return TimeOfDay(Option[CivilTime](opt).Filter(pred))
+}
+
+func (opt TimeOfDay) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt TimeOfDay) getValue() CivilTime {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt TimeOfDay) String() string {
+	return Option[CivilTime](opt).String()
+}
+
+// Add returns the contained time moved by d, wrapping around midnight, or
+// None.
+func (opt TimeOfDay) Add(d time.Duration) TimeOfDay {
+	if !opt.getHasValue() {
+		return TimeOfDay{}
+	}
+	return SomeTimeOfDay(opt.getValue().Add(d))
+}
+
+// Before returns true if both optionals contain a time and opt is before
+// optb.
+func (opt TimeOfDay) Before(optb TimeOfDay) bool {
+	return opt.getHasValue() && optb.getHasValue() && opt.getValue().Before(optb.getValue())
+}
+
+// After returns true if both optionals contain a time and opt is after optb.
+func (opt TimeOfDay) After(optb TimeOfDay) bool {
+	return opt.getHasValue() && optb.getHasValue() && opt.getValue().After(optb.getValue())
+}
+
+// MarshalJSON implements the json Marshaler interface. The time is written as
+// "15:04:05", followed by the fraction of a second and the offset if there
+// are any.
+func (opt TimeOfDay) MarshalJSON() ([]byte, error) {
+	if !opt.getHasValue() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + opt.getValue().String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *TimeOfDay) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || data == nil {
+		opt.value, opt.hasValue = CivilTime{}, false
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		opt.hasValue = false
+		return errors.WithStack(err)
+	}
+
+	value, err := ParseTimeOfDay(s)
+	if err != nil {
+		opt.hasValue = false
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface. It accepts the text forms
+// ParseTimeOfDay does, and a time.Time, whose clock reading is taken along
+// with its offset if it is not in UTC.
+func (opt *TimeOfDay) Scan(src interface{}) error {
+	if src == nil {
+		opt.value, opt.hasValue = CivilTime{}, false
+		return nil
+	}
+
+	var value CivilTime
+	err := value.Scan(src)
+	if err != nil {
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. The time is sent as text.
+func (opt TimeOfDay) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+	return opt.getValue().Value()
+}
+
+// CivilTime is a time of day with nanosecond precision, and an optional UTC
+// offset for timetz values.
+type CivilTime struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+	Offset     Int // seconds east of UTC, if any
+}
+
+// TimeOfDayOf returns the clock reading of t, without an offset.
+func TimeOfDayOf(t time.Time) CivilTime {
+	return CivilTime{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// ParseTimeOfDay parses the time text forms of Postgres and MySQL:
+// "15:04", "15:04:05" and "15:04:05.999999", optionally followed by an offset
+// such as "Z", "+05", "-08:00" or "+0530". Postgres's "24:00:00" is accepted.
+func ParseTimeOfDay(s string) (CivilTime, error) {
+	var t CivilTime
+	invalid := errors.Errorf("null: invalid time of day %q", s)
+
+	clock := s
+	if i := strings.IndexAny(s, "+-Z"); i >= 0 {
+		offset, ok := parseOffset(s[i:])
+		if !ok {
+			return t, invalid
+		}
+		clock, t.Offset = s[:i], SomeInt(offset)
+	}
+
+	parts := strings.Split(clock, ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return t, invalid
+	}
+	if len(parts) == 3 {
+		if i := strings.IndexByte(parts[2], '.'); i >= 0 {
+			fraction := parts[2][i+1:]
+			if fraction == "" || len(fraction) > 9 || !isDigits(fraction) {
+				return t, invalid
+			}
+			t.Nanosecond, _ = strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction)))
+			parts[2] = parts[2][:i]
+		}
+	}
+	fields := []*int{&t.Hour, &t.Minute, &t.Second}
+	for i, part := range parts {
+		if len(part) != 2 || !isDigits(part) {
+			return t, invalid
+		}
+		*fields[i], _ = strconv.Atoi(part)
+	}
+
+	end := t.Hour == 24 && t.Minute == 0 && t.Second == 0 && t.Nanosecond == 0
+	if (t.Hour > 23 && !end) || t.Minute > 59 || t.Second > 59 {
+		return t, invalid
+	}
+	return t, nil
+}
+
+// parseOffset parses "Z", "+05", "+05:30", "+0530" or "+05:30:15" into
+// seconds east of UTC. Like Postgres, it accepts offsets up to ±15:59:59.
+func parseOffset(s string) (int, bool) {
+	if s == "Z" {
+		return 0, true
+	}
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return 0, false
+	}
+
+	digits := strings.Replace(s[1:], ":", "", -1)
+	if len(digits)%2 != 0 || len(digits) > 6 || !isDigits(digits) {
+		return 0, false
+	}
+	seconds := 0
+	for i, unit := range []int{3600, 60, 1} {
+		if 2*i >= len(digits) {
+			break
+		}
+		n, _ := strconv.Atoi(digits[2*i : 2*i+2])
+		if (unit == 3600 && n > 15) || n > 59 {
+			return 0, false
+		}
+		seconds += n * unit
+	}
+	if s[0] == '-' {
+		seconds = -seconds
+	}
+	return seconds, true
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// sinceMidnight returns the time elapsed since midnight.
+func (t CivilTime) sinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// String returns the time as "15:04:05", followed by the fraction of a second
+// and the offset if there are any, e.g. "15:04:05.5+05:30".
+func (t CivilTime) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%09d", t.Nanosecond), "0")
+	}
+	if offset, ok := t.Offset.Unwrap(); ok {
+		sign := '+'
+		if offset < 0 {
+			sign, offset = '-', -offset
+		}
+		s += fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+		if offset%60 != 0 {
+			s += fmt.Sprintf(":%02d", offset%60)
+		}
+	}
+	return s
+}
+
+// Add returns t moved by d, wrapping around midnight. The offset is kept.
+func (t CivilTime) Add(d time.Duration) CivilTime {
+	const day = 24 * time.Hour
+	since := (t.sinceMidnight() + d%day + day) % day
+	return CivilTime{
+		Hour:       int(since / time.Hour),
+		Minute:     int(since % time.Hour / time.Minute),
+		Second:     int(since % time.Minute / time.Second),
+		Nanosecond: int(since % time.Second),
+		Offset:     t.Offset,
+	}
+}
+
+// Compare returns -1, 0 or +1 depending on whether t is before, equal to, or
+// after u. Like Postgres's timetz, times that both have an offset are
+// compared in UTC.
+func (t CivilTime) Compare(u CivilTime) int {
+	x, y := t.sinceMidnight(), u.sinceMidnight()
+	offsetT, okT := t.Offset.Unwrap()
+	offsetU, okU := u.Offset.Unwrap()
+	if okT && okU {
+		x -= time.Duration(offsetT) * time.Second
+		y -= time.Duration(offsetU) * time.Second
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// Before returns true if t is before u.
+func (t CivilTime) Before(u CivilTime) bool {
+	return t.Compare(u) < 0
+}
+
+// After returns true if t is after u.
+func (t CivilTime) After(u CivilTime) bool {
+	return t.Compare(u) > 0
+}
+
+// MarshalText implements the encoding TextMarshaler interface.
+func (t CivilTime) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding TextUnmarshaler interface.
+func (t *CivilTime) UnmarshalText(text []byte) error {
+	value, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return err
+	}
+	*t = value
+	return nil
+}
+
+// Scan implements the sql Scanner interface, so Option[CivilTime] and
+// Patch[CivilTime] can be scanned. It accepts the text forms ParseTimeOfDay
+// does, and a time.Time, whose clock reading is taken. Drivers such as lib/pq
+// hand over timetz values as a time.Time in a fixed zone, so a time.Time
+// outside UTC keeps its zone's offset. NULL is an error; use TimeOfDay for
+// nullable columns.
+func (t *CivilTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return errors.New("null: cannot scan NULL into CivilTime")
+	case time.Time:
+		*t = TimeOfDayOf(v)
+		if v.Location() != time.UTC {
+			_, offset := v.Zone()
+			t.Offset = SomeInt(offset)
+		}
+		return nil
+	}
+
+	var s string
+	err := internal.ConvertAssign(&s, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	value, err := ParseTimeOfDay(s)
+	if err != nil {
+		return err
+	}
+	*t = value
+	return nil
+}
+
+// Value implements the driver Valuer interface, so Option[CivilTime] and
+// Patch[CivilTime] can be sent. The time is sent as text.
+func (t CivilTime) Value() (driver.Value, error) {
+	return t.String(), nil
+}
@@ -0,0 +1,145 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		in   string
+		want CivilTime
+	}{
+		{"00:00", CivilTime{}},
+		{"15:04", CivilTime{Hour: 15, Minute: 4}},
+		{"15:04:05", CivilTime{Hour: 15, Minute: 4, Second: 5}},
+		{"15:04:05.5", CivilTime{Hour: 15, Minute: 4, Second: 5, Nanosecond: 500000000}},
+		{"15:04:05.999999", CivilTime{Hour: 15, Minute: 4, Second: 5, Nanosecond: 999999000}},
+		{"15:04:05.123456789", CivilTime{Hour: 15, Minute: 4, Second: 5, Nanosecond: 123456789}},
+		{"23:59:59", CivilTime{Hour: 23, Minute: 59, Second: 59}},
+		{"24:00:00", CivilTime{Hour: 24}},
+		{"24:00", CivilTime{Hour: 24}},
+		{"15:04:05Z", CivilTime{Hour: 15, Minute: 4, Second: 5, Offset: SomeInt(0)}},
+		{"15:04:05+05", CivilTime{Hour: 15, Minute: 4, Second: 5, Offset: SomeInt(5 * 3600)}},
+		{"15:04:05-08:00", CivilTime{Hour: 15, Minute: 4, Second: 5, Offset: SomeInt(-8 * 3600)}},
+		{"15:04:05+0530", CivilTime{Hour: 15, Minute: 4, Second: 5, Offset: SomeInt(5*3600 + 30*60)}},
+		{"15:04:05.25+05:30:15", CivilTime{Hour: 15, Minute: 4, Second: 5, Nanosecond: 250000000, Offset: SomeInt(5*3600 + 30*60 + 15)}},
+		{"24:00:00+00", CivilTime{Hour: 24, Offset: SomeInt(0)}},
+		{"15:04:05+15:59:59", CivilTime{Hour: 15, Minute: 4, Second: 5, Offset: SomeInt(15*3600 + 59*60 + 59)}},
+		{"15:04:05-15:59", CivilTime{Hour: 15, Minute: 4, Second: 5, Offset: SomeInt(-(15*3600 + 59*60))}},
+	}
+	for _, tt := range tests {
+		got, err := ParseTimeOfDay(tt.in)
+		if err != nil {
+			t.Errorf("ParseTimeOfDay(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseTimeOfDay(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimeOfDayInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"15",
+		"5:04",
+		"15:4",
+		"15:04:5",
+		"15:04:05:00",
+		"24:00:01",
+		"24:01",
+		"24:00:00.1",
+		"25:00",
+		"15:60",
+		"15:04:60",
+		"15:04:05.",
+		"15:04:05.1234567890",
+		"15:04:05.x",
+		"15:04:05+",
+		"15:04:05+5",
+		"15:04:05+05:3",
+		"15:04:05+05:30:15:00",
+		"15:04:05+99",
+		"15:04:05+16",
+		"15:04:05-16:00",
+		"15:04:05+05:60",
+		"15:04:05+05:30:60",
+		"15:04:05 +05",
+		"ab:cd",
+	}
+	for _, in := range tests {
+		if got, err := ParseTimeOfDay(in); err == nil {
+			t.Errorf("ParseTimeOfDay(%q) = %+v, want error", in, got)
+		}
+	}
+}
+
+func TestCivilTimeString(t *testing.T) {
+	tests := []struct {
+		in   CivilTime
+		want string
+	}{
+		{CivilTime{}, "00:00:00"},
+		{CivilTime{Hour: 24}, "24:00:00"},
+		{CivilTime{Hour: 15, Minute: 4, Second: 5, Nanosecond: 500000000}, "15:04:05.5"},
+		{CivilTime{Hour: 15, Offset: SomeInt(5*3600 + 30*60)}, "15:00:00+05:30"},
+		{CivilTime{Hour: 15, Offset: SomeInt(-(8*3600 + 15))}, "15:00:00-08:00:15"},
+		{CivilTime{Hour: 15, Offset: SomeInt(0)}, "15:00:00+00:00"},
+	}
+	for _, tt := range tests {
+		got := tt.in.String()
+		if got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.in, got, tt.want)
+		}
+		back, err := ParseTimeOfDay(got)
+		if err != nil || back != tt.in {
+			t.Errorf("ParseTimeOfDay(%q) = %+v, %v, want %+v", got, back, err, tt.in)
+		}
+	}
+}
+
+func TestCivilTimeAddCompare(t *testing.T) {
+	tests := []struct {
+		in   CivilTime
+		d    time.Duration
+		want CivilTime
+	}{
+		{CivilTime{Hour: 23}, 2 * time.Hour, CivilTime{Hour: 1}},
+		{CivilTime{Hour: 1}, -2 * time.Hour, CivilTime{Hour: 23}},
+		{CivilTime{Hour: 24}, time.Minute, CivilTime{Minute: 1}},
+		{CivilTime{Hour: 12}, 49 * time.Hour, CivilTime{Hour: 13}},
+	}
+	for _, tt := range tests {
+		if got := tt.in.Add(tt.d); got != tt.want {
+			t.Errorf("%s.Add(%s) = %s, want %s", tt.in, tt.d, got, tt.want)
+		}
+	}
+
+	utc := CivilTime{Hour: 10, Offset: SomeInt(0)}
+	east := CivilTime{Hour: 12, Offset: SomeInt(3 * 3600)} // 09:00 UTC
+	if !east.Before(utc) || !utc.After(east) {
+		t.Errorf("%s is not before %s", east, utc)
+	}
+	if (CivilTime{Hour: 24}).Compare(CivilTime{Hour: 23, Minute: 59}) != 1 {
+		t.Error("24:00:00 is not after 23:59:00")
+	}
+}
+
+func TestTimeOfDayScanTime(t *testing.T) {
+	tests := []struct {
+		src  time.Time
+		want CivilTime
+	}{
+		{time.Date(0, 1, 1, 15, 4, 5, 0, time.UTC), CivilTime{Hour: 15, Minute: 4, Second: 5}},
+		{time.Date(0, 1, 1, 15, 4, 5, 0, time.FixedZone("", 5*3600+30*60)), CivilTime{Hour: 15, Minute: 4, Second: 5, Offset: SomeInt(5*3600 + 30*60)}},
+		{time.Date(0, 1, 1, 15, 4, 5, 0, time.FixedZone("", -8*3600)), CivilTime{Hour: 15, Minute: 4, Second: 5, Offset: SomeInt(-8 * 3600)}},
+	}
+	for _, tt := range tests {
+		var got TimeOfDay
+		if err := got.Scan(tt.src); err != nil || got != SomeTimeOfDay(tt.want) {
+			t.Errorf("Scan(%s) = %s, %v, want %s", tt.src, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package null
+
+import "database/sql/driver"
+
+type Uint16 Option[uint16]
+
+func NewUint16(value uint16, hasValue bool) Uint16 {
+	opt := &Uint16{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeUint16 returns a Uint16 containing value.
+func SomeUint16(value uint16) Uint16 {
+	return NewUint16(value, true)
+}
+
+// NoneUint16 returns a Uint16 containing no value.
+func NoneUint16() Uint16 {
+	return Uint16{}
+}
+
+// SetValue performs the conversion.
+func (opt *Uint16) SetValue(value uint16) {
+	(*Option[uint16])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Uint16) Clear() {
+	(*Option[uint16])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Uint16) Take() Uint16 {
+	return Uint16((*Option[uint16])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Uint16) Replace(value uint16) Uint16 {
+	return Uint16((*Option[uint16])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Uint16) GetOrInsert(value uint16) uint16 {
+	return (*Option[uint16])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Uint16) GetOrInsertWith(fn func() uint16) uint16 {
+	return (*Option[uint16])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Uint16) Unwrap() (uint16, bool) {
+	return Option[uint16](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Uint16) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Uint16) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Uint16) UnwrapOr(def uint16) uint16 {
+	return Option[uint16](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Uint16) UnwrapOrElse(fn func() uint16) uint16 {
+	return Option[uint16](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Uint16) UnwrapOrDefault() uint16 {
+	return Option[uint16](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Uint16) UnwrapOrPanic() uint16 {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Uint16")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Uint16) Or(optb Uint16) Uint16 {
+	return Uint16(Option[uint16](opt).Or(Option[uint16](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Uint16) OrElse(fn func() Uint16) Uint16 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Uint16) And(optb Uint16) Uint16 {
+	return Uint16(Option[uint16](opt).And(Option[uint16](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Uint16) Xor(optb Uint16) Uint16 {
+	return Uint16(Option[uint16](opt).Xor(Option[uint16](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Uint16) Filter(pred func(uint16) bool) Uint16 {
+	return Uint16(Option[uint16](opt).Filter(pred))
+}
+
+func (opt Uint16) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Uint16) getValue() uint16 {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Uint16) String() string {
+	return Option[uint16](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Uint16) MarshalJSON() ([]byte, error) {
+	return Option[uint16](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Uint16) UnmarshalJSON(data []byte) error {
+	return (*Option[uint16])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *Uint16) Scan(src interface{}) error {
+	return (*Option[uint16])(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface.
+func (opt Uint16) Value() (driver.Value, error) {
+	return Option[uint16](opt).Value()
+}
@@ -0,0 +1,159 @@
+package null
+
+import "database/sql/driver"
+
+type Uint32 Option[uint32]
+
+func NewUint32(value uint32, hasValue bool) Uint32 {
+	opt := &Uint32{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeUint32 returns a Uint32 containing value.
+func SomeUint32(value uint32) Uint32 {
+	return NewUint32(value, true)
+}
+
+// NoneUint32 returns a Uint32 containing no value.
+func NoneUint32() Uint32 {
+	return Uint32{}
+}
+
+// SetValue performs the conversion.
+func (opt *Uint32) SetValue(value uint32) {
+	(*Option[uint32])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Uint32) Clear() {
+	(*Option[uint32])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Uint32) Take() Uint32 {
+	return Uint32((*Option[uint32])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Uint32) Replace(value uint32) Uint32 {
+	return Uint32((*Option[uint32])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Uint32) GetOrInsert(value uint32) uint32 {
+	return (*Option[uint32])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Uint32) GetOrInsertWith(fn func() uint32) uint32 {
+	return (*Option[uint32])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Uint32) Unwrap() (uint32, bool) {
+	return Option[uint32](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Uint32) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Uint32) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Uint32) UnwrapOr(def uint32) uint32 {
+	return Option[uint32](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Uint32) UnwrapOrElse(fn func() uint32) uint32 {
+	return Option[uint32](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Uint32) UnwrapOrDefault() uint32 {
+	return Option[uint32](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Uint32) UnwrapOrPanic() uint32 {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Uint32")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Uint32) Or(optb Uint32) Uint32 {
+	return Uint32(Option[uint32](opt).Or(Option[uint32](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Uint32) OrElse(fn func() Uint32) Uint32 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Uint32) And(optb Uint32) Uint32 {
+	return Uint32(Option[uint32](opt).And(Option[uint32](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Uint32) Xor(optb Uint32) Uint32 {
+	return Uint32(Option[uint32](opt).Xor(Option[uint32](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Uint32) Filter(pred func(uint32) bool) Uint32 {
+	return Uint32(Option[uint32](opt).Filter(pred))
+}
+
+func (opt Uint32) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Uint32) getValue() uint32 {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Uint32) String() string {
+	return Option[uint32](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Uint32) MarshalJSON() ([]byte, error) {
+	return Option[uint32](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Uint32) UnmarshalJSON(data []byte) error {
+	return (*Option[uint32])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *Uint32) Scan(src interface{}) error {
+	return (*Option[uint32])(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface.
+func (opt Uint32) Value() (driver.Value, error) {
+	return Option[uint32](opt).Value()
+}
@@ -0,0 +1,171 @@
+package null
+
+import (
+	"database/sql/driver"
+	"math"
+	"strconv"
+)
+
+type Uint64 Option[uint64]
+
+func NewUint64(value uint64, hasValue bool) Uint64 {
+	opt := &Uint64{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeUint64 returns a Uint64 containing value.
+func SomeUint64(value uint64) Uint64 {
+	return NewUint64(value, true)
+}
+
+// NoneUint64 returns a Uint64 containing no value.
+func NoneUint64() Uint64 {
+	return Uint64{}
+}
+
+// SetValue performs the conversion.
+func (opt *Uint64) SetValue(value uint64) {
+	(*Option[uint64])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Uint64) Clear() {
+	(*Option[uint64])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Uint64) Take() Uint64 {
+	return Uint64((*Option[uint64])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Uint64) Replace(value uint64) Uint64 {
+	return Uint64((*Option[uint64])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Uint64) GetOrInsert(value uint64) uint64 {
+	return (*Option[uint64])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Uint64) GetOrInsertWith(fn func() uint64) uint64 {
+	return (*Option[uint64])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Uint64) Unwrap() (uint64, bool) {
+	return Option[uint64](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Uint64) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Uint64) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Uint64) UnwrapOr(def uint64) uint64 {
+	return Option[uint64](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Uint64) UnwrapOrElse(fn func() uint64) uint64 {
+	return Option[uint64](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Uint64) UnwrapOrDefault() uint64 {
+	return Option[uint64](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Uint64) UnwrapOrPanic() uint64 {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Uint64")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Uint64) Or(optb Uint64) Uint64 {
+	return Uint64(Option[uint64](opt).Or(Option[uint64](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Uint64) OrElse(fn func() Uint64) Uint64 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Uint64) And(optb Uint64) Uint64 {
+	return Uint64(Option[uint64](opt).And(Option[uint64](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Uint64) Xor(optb Uint64) Uint64 {
+	return Uint64(Option[uint64](opt).Xor(Option[uint64](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Uint64) Filter(pred func(uint64) bool) Uint64 {
+	return Uint64(Option[uint64](opt).Filter(pred))
+}
+
+func (opt Uint64) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Uint64) getValue() uint64 {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Uint64) String() string {
+	return Option[uint64](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Uint64) MarshalJSON() ([]byte, error) {
+	return Option[uint64](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Uint64) UnmarshalJSON(data []byte) error {
+	return (*Option[uint64])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *Uint64) Scan(src interface{}) error {
+	return (*Option[uint64])(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface. driver.Value can't carry
+// uint64 values above math.MaxInt64, so those are sent as a decimal string.
+func (opt Uint64) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+
+	if opt.getValue() > math.MaxInt64 {
+		return strconv.FormatUint(opt.getValue(), 10), nil
+	}
+	return int64(opt.getValue()), nil
+}
@@ -0,0 +1,159 @@
+package null
+
+import "database/sql/driver"
+
+type Uint8 Option[uint8]
+
+func NewUint8(value uint8, hasValue bool) Uint8 {
+	opt := &Uint8{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeUint8 returns a Uint8 containing value.
+func SomeUint8(value uint8) Uint8 {
+	return NewUint8(value, true)
+}
+
+// NoneUint8 returns a Uint8 containing no value.
+func NoneUint8() Uint8 {
+	return Uint8{}
+}
+
+// SetValue performs the conversion.
+func (opt *Uint8) SetValue(value uint8) {
+	(*Option[uint8])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *Uint8) Clear() {
+	(*Option[uint8])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *Uint8) Take() Uint8 {
+	return Uint8((*Option[uint8])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *Uint8) Replace(value uint8) Uint8 {
+	return Uint8((*Option[uint8])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *Uint8) GetOrInsert(value uint8) uint8 {
+	return (*Option[uint8])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *Uint8) GetOrInsertWith(fn func() uint8) uint8 {
+	return (*Option[uint8])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt Uint8) Unwrap() (uint8, bool) {
+	return Option[uint8](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt Uint8) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt Uint8) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt Uint8) UnwrapOr(def uint8) uint8 {
+	return Option[uint8](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt Uint8) UnwrapOrElse(fn func() uint8) uint8 {
+	return Option[uint8](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt Uint8) UnwrapOrDefault() uint8 {
+	return Option[uint8](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt Uint8) UnwrapOrPanic() uint8 {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap Uint8")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt Uint8) Or(optb Uint8) Uint8 {
+	return Uint8(Option[uint8](opt).Or(Option[uint8](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt Uint8) OrElse(fn func() Uint8) Uint8 {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt Uint8) And(optb Uint8) Uint8 {
+	return Uint8(Option[uint8](opt).And(Option[uint8](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt Uint8) Xor(optb Uint8) Uint8 {
+	return Uint8(Option[uint8](opt).Xor(Option[uint8](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt Uint8) Filter(pred func(uint8) bool) Uint8 {
+	return Uint8(Option[uint8](opt).Filter(pred))
+}
+
+func (opt Uint8) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt Uint8) getValue() uint8 {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt Uint8) String() string {
+	return Option[uint8](opt).String()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (opt Uint8) MarshalJSON() ([]byte, error) {
+	return Option[uint8](opt).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (opt *Uint8) UnmarshalJSON(data []byte) error {
+	return (*Option[uint8])(opt).UnmarshalJSON(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (opt *Uint8) Scan(src interface{}) error {
+	return (*Option[uint8])(opt).Scan(src)
+}
+
+// Value implements the driver Valuer interface.
+func (opt Uint8) Value() (driver.Value, error) {
+	return Option[uint8](opt).Value()
+}
@@ -0,0 +1,355 @@
+package null
+
+import (
+	"bytes"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gurpartap/null/internal"
+)
+
+// UUID is a nullable UUID, stored as its 16 bytes. [16]byte converts to and
+// from the UUID types of other packages, such as github.com/google/uuid.
+//
+// [16]byte has no Scan or Value methods, so an Option[[16]byte], such as the
+// result of Map, can't be bound to a query as is; convert it with
+// UUID(opt) first. Patch[[16]byte] scans and sends values through UUID.
+type UUID Option[[16]byte]
+
+// UUIDFormat selects how UUID Value sends values to the database.
+type UUIDFormat int
+
+const (
+	// UUIDAsText sends the canonical 36 character form, for Postgres uuid
+	// columns.
+	UUIDAsText UUIDFormat = iota
+	// UUIDAsBytes sends the 16 raw bytes, for MySQL binary(16) columns.
+	UUIDAsBytes
+)
+
+// UUIDValueFormat is how UUID Value sends values. Scan accepts both forms.
+var UUIDValueFormat = UUIDAsText
+
+func NewUUID(value [16]byte, hasValue bool) UUID {
+	opt := &UUID{}
+	if hasValue {
+		opt.SetValue(value)
+	}
+	return *opt
+}
+
+// SomeUUID returns a UUID containing value.
+func SomeUUID(value [16]byte) UUID {
+	return NewUUID(value, true)
+}
+
+// NoneUUID returns a UUID containing no value.
+func NoneUUID() UUID {
+	return UUID{}
+}
+
+// SetValue performs the conversion.
+func (opt *UUID) SetValue(value [16]byte) {
+	(*Option[[16]byte])(opt).SetValue(value)
+}
+
+// Clear sets the optional to None.
+func (opt *UUID) Clear() {
+	(*Option[[16]byte])(opt).Clear()
+}
+
+// Take returns the optional and leaves None in its place.
+func (opt *UUID) Take() UUID {
+	return UUID((*Option[[16]byte])(opt).Take())
+}
+
+// Replace sets value on the optional and returns the previous optional.
+func (opt *UUID) Replace(value [16]byte) UUID {
+	return UUID((*Option[[16]byte])(opt).Replace(value))
+}
+
+// GetOrInsert sets value on the optional if it is None, then returns the
+// contained value.
+func (opt *UUID) GetOrInsert(value [16]byte) [16]byte {
+	return (*Option[[16]byte])(opt).GetOrInsert(value)
+}
+
+// GetOrInsertWith sets the value computed from a closure on the optional if
+// it is None, then returns the contained value.
+func (opt *UUID) GetOrInsertWith(fn func() [16]byte) [16]byte {
+	return (*Option[[16]byte])(opt).GetOrInsertWith(fn)
+}
+
+// Unwrap moves the value out of the optional, if it is Some(value).
+// This function returns multiple values, and if that's undesirable,
+// consider using Some and None functions.
+func (opt UUID) Unwrap() ([16]byte, bool) {
+	return Option[[16]byte](opt).Unwrap()
+}
+
+// IsSome returns true if the optional contains a value.
+func (opt UUID) IsSome() bool {
+	return opt.getHasValue()
+}
+
+// IsNone returns true if the optional contains no value.
+func (opt UUID) IsNone() bool {
+	return !opt.getHasValue()
+}
+
+// UnwrapOr returns the contained value or a default.
+func (opt UUID) UnwrapOr(def [16]byte) [16]byte {
+	return Option[[16]byte](opt).UnwrapOr(def)
+}
+
+// UnwrapOrElse returns the contained value or computes it from a closure.
+func (opt UUID) UnwrapOrElse(fn func() [16]byte) [16]byte {
+	return Option[[16]byte](opt).UnwrapOrElse(fn)
+}
+
+// UnwrapOrDefault returns the contained value or the default.
+func (opt UUID) UnwrapOrDefault() [16]byte {
+	return Option[[16]byte](opt).UnwrapOrDefault()
+}
+
+// UnwrapOrPanic returns the contained value or panics.
+func (opt UUID) UnwrapOrPanic() [16]byte {
+	if opt.getHasValue() {
+		return opt.getValue()
+	}
+	panic("unable to unwrap UUID")
+}
+
+// Or returns the optional if it contains a value, otherwise returns optb.
+func (opt UUID) Or(optb UUID) UUID {
+	return UUID(Option[[16]byte](opt).Or(Option[[16]byte](optb)))
+}
+
+// OrElse returns the optional if it contains a value, otherwise calls fn and
+// returns the result.
+func (opt UUID) OrElse(fn func() UUID) UUID {
+	if opt.getHasValue() {
+		return opt
+	}
+	return fn()
+}
+
+// And returns None if the optional is None, otherwise returns optb.
+func (opt UUID) And(optb UUID) UUID {
+	return UUID(Option[[16]byte](opt).And(Option[[16]byte](optb)))
+}
+
+// Xor returns Some if exactly one of opt and optb is Some, otherwise returns
+// None.
+func (opt UUID) Xor(optb UUID) UUID {
+	return UUID(Option[[16]byte](opt).Xor(Option[[16]byte](optb)))
+}
+
+// Filter returns the optional if it contains a value and pred returns true
+// for it, otherwise returns None.
+func (opt UUID) Filter(pred func([16]byte) bool) UUID {
+	return UUID(Option[[16]byte](opt).Filter(pred))
+}
+
+func (opt UUID) getHasValue() bool {
+	return opt.hasValue
+}
+
+func (opt UUID) getValue() [16]byte {
+	return opt.value
+}
+
+// String conforms to fmt Stringer interface.
+func (opt UUID) String() string {
+	if value, ok := opt.Unwrap(); ok {
+		return fmt.Sprintf("Some(%s)", FormatUUID(value))
+	}
+	return "null"
+}
+
+// MarshalJSON implements the json Marshaler interface. The value is written
+// in the canonical form.
+func (opt UUID) MarshalJSON() ([]byte, error) {
+	if !opt.getHasValue() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + FormatUUID(opt.getValue()) + `"`), nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. Any of the forms
+// ParseUUID accepts is read.
+func (opt *UUID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || data == nil {
+		opt.value, opt.hasValue = [16]byte{}, false
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		opt.hasValue = false
+		return errors.WithStack(err)
+	}
+
+	value, err := ParseUUID(s)
+	if err != nil {
+		opt.hasValue = false
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface. It accepts the text forms
+// ParseUUID does, and 16 raw bytes as stored in MySQL binary(16) columns.
+func (opt *UUID) Scan(src interface{}) error {
+	if src == nil {
+		opt.value, opt.hasValue = [16]byte{}, false
+		return nil
+	}
+
+	if b, ok := src.([]byte); ok && len(b) == 16 {
+		var value [16]byte
+		copy(value[:], b)
+		opt.SetValue(value)
+		return nil
+	}
+
+	var s string
+	err := internal.ConvertAssign(&s, src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	value, err := ParseUUID(s)
+	if err != nil {
+		return err
+	}
+	opt.SetValue(value)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. The format follows
+// UUIDValueFormat.
+func (opt UUID) Value() (driver.Value, error) {
+	if !opt.getHasValue() {
+		return nil, nil
+	}
+
+	value := opt.getValue()
+	if UUIDValueFormat == UUIDAsBytes {
+		return value[:], nil
+	}
+	return FormatUUID(value), nil
+}
+
+// ParseUUID parses a UUID in the canonical form
+// "6ba7b810-9dad-11d1-80b4-00c04fd430c8", the braced form
+// "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", the URN form
+// "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", or the hyphenless form
+// "6ba7b8109dad11d180b400c04fd430c8". Hex digits may be in either case.
+func ParseUUID(s string) ([16]byte, error) {
+	var value [16]byte
+
+	text := s
+	switch len(text) {
+	case 36:
+	case 38:
+		if text[0] != '{' || text[37] != '}' {
+			return value, errors.Errorf("null: invalid UUID %q", s)
+		}
+		text = text[1:37]
+	case 45:
+		if !strings.EqualFold(text[:9], "urn:uuid:") {
+			return value, errors.Errorf("null: invalid UUID %q", s)
+		}
+		text = text[9:]
+	case 32:
+		_, err := hex.Decode(value[:], []byte(text))
+		if err != nil {
+			return value, errors.Errorf("null: invalid UUID %q", s)
+		}
+		return value, nil
+	default:
+		return value, errors.Errorf("null: invalid UUID %q", s)
+	}
+
+	if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+		return value, errors.Errorf("null: invalid UUID %q", s)
+	}
+	hexText := text[0:8] + text[9:13] + text[14:18] + text[19:23] + text[24:]
+	_, err := hex.Decode(value[:], []byte(hexText))
+	if err != nil {
+		return value, errors.Errorf("null: invalid UUID %q", s)
+	}
+	return value, nil
+}
+
+// MustParseUUID is like ParseUUID but panics if s can't be parsed.
+func MustParseUUID(s string) [16]byte {
+	value, err := ParseUUID(s)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// FormatUUID returns value in the canonical lower case form
+// "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+func FormatUUID(value [16]byte) string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], value[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], value[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], value[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], value[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], value[10:])
+	return string(buf[:])
+}
+
+// UUIDv4 returns a new random (version 4) UUID.
+func UUIDv4() [16]byte {
+	var value [16]byte
+	randomUUID(value[:])
+	value[6] = value[6]&0x0f | 0x40 // version 4
+	value[8] = value[8]&0x3f | 0x80 // RFC 4122 variant
+	return value
+}
+
+// UUIDv7 returns a new time-ordered (version 7) UUID: a millisecond Unix
+// timestamp followed by random bits. UUIDs generated in later milliseconds
+// sort after earlier ones.
+func UUIDv7() [16]byte {
+	var value [16]byte
+	randomUUID(value[6:])
+
+	ms := uint64(time.Now().UnixMilli())
+	value[0] = byte(ms >> 40)
+	value[1] = byte(ms >> 32)
+	value[2] = byte(ms >> 24)
+	value[3] = byte(ms >> 16)
+	value[4] = byte(ms >> 8)
+	value[5] = byte(ms)
+	value[6] = value[6]&0x0f | 0x70 // version 7
+	value[8] = value[8]&0x3f | 0x80 // RFC 4122 variant
+	return value
+}
+
+func randomUUID(b []byte) {
+	_, err := rand.Read(b)
+	if err != nil {
+		panic(errors.Wrap(err, "null: reading random bytes for UUID"))
+	}
+}
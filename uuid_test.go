@@ -0,0 +1,61 @@
+package null
+
+import "testing"
+
+func TestParseUUID(t *testing.T) {
+	want := [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	tests := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+	}
+	for _, in := range tests {
+		got, err := ParseUUID(in)
+		if err != nil || got != want {
+			t.Errorf("ParseUUID(%q) = %x, %v, want %x", in, got, err, want)
+		}
+	}
+}
+
+func TestParseUUIDInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"6ba7b810",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8a",
+		"6ba7b810x9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109-dad-11d1-80b4-00c04fd430c8",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430cg",
+		"(6ba7b810-9dad-11d1-80b4-00c04fd430c8)",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"urn:uid:-6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430cz",
+		"+ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	}
+	for _, in := range tests {
+		if got, err := ParseUUID(in); err == nil {
+			t.Errorf("ParseUUID(%q) = %x, want error", in, got)
+		}
+	}
+}
+
+func TestUUIDVersions(t *testing.T) {
+	for name, value := range map[string][16]byte{"v4": UUIDv4(), "v7": UUIDv7()} {
+		back, err := ParseUUID(FormatUUID(value))
+		if err != nil || back != value {
+			t.Errorf("%s: ParseUUID(FormatUUID(%x)) = %x, %v", name, value, back, err)
+		}
+		if value[8]&0xc0 != 0x80 {
+			t.Errorf("%s: variant bits of %x are not RFC 4122", name, value)
+		}
+	}
+	if v := UUIDv4()[6] >> 4; v != 4 {
+		t.Errorf("UUIDv4 version = %d", v)
+	}
+	if v := UUIDv7()[6] >> 4; v != 7 {
+		t.Errorf("UUIDv7 version = %d", v)
+	}
+}